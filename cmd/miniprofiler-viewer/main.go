@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+/*
+Command miniprofiler-viewer serves the profiler's UI and results pages for
+profiles stored by another process, so applications can keep profiling
+enabled without exposing the results themselves.
+
+Usage:
+
+	miniprofiler-viewer -dir dir [-addr localhost:8080] [-path /mini-profiler-resources/] [-forwarded-prefix]
+
+The application stores its profiles in a miniprofiler.FileStore in dir:
+
+	fs := miniprofiler.NewFileStore(dir)
+	mp.Store, mp.Get, mp.List = fs.Store, fs.Get, fs.List
+
+and the viewer serves them from the same directory: the results index at /,
+and each profile's page at results?id=<id> under -path, which must match the
+application's Profiler.Path for the popup's links to work. The viewer has no
+access control of its own, so listen only on an internal network.
+*/
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+)
+
+var (
+	addr            = flag.String("addr", "localhost:8080", "address to listen on")
+	dir             = flag.String("dir", "", "directory of a miniprofiler.FileStore")
+	path            = flag.String("path", miniprofiler.PATH, "URL path of the profiler's resources")
+	forwardedPrefix = flag.Bool("forwarded-prefix", false, "prepend the X-Forwarded-Prefix header to URLs")
+)
+
+func main() {
+	flag.Parse()
+	if *dir == "" {
+		log.Fatal("-dir is required")
+	}
+	fs := miniprofiler.NewFileStore(*dir)
+	mp := miniprofiler.DefaultProfiler
+	mp.Enable = func(*http.Request) bool { return false }
+	// Access control is left to the network the viewer listens on.
+	mp.Authorize = func(*http.Request) bool { return true }
+	mp.Store = fs.Store
+	mp.Get = fs.Get
+	mp.List = fs.List
+	mp.Path = *path
+	mp.ForwardedPrefix = *forwardedPrefix
+
+	http.HandleFunc(mp.Path, miniprofiler.MiniProfilerHandler)
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		// Redirect relative to /, so a proxy's path prefix is kept.
+		http.Redirect(w, r, strings.TrimPrefix(mp.Path, "/")+"results-index", http.StatusFound)
+	})
+	log.Printf("serving profiles from %s on %s", fs.Dir, *addr)
+	log.Fatal(http.ListenAndServe(*addr, nil))
+}
@@ -0,0 +1,266 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+/*
+Command miniprofiler inspects stored profiles from a terminal.
+
+Usage:
+
+	miniprofiler [-dir dir] list [-n 20] [-name route] [-status 5xx] [-tag key:value] [-min 100ms]
+	miniprofiler [-dir dir] show <id or file>
+	miniprofiler [-dir dir] export -format chrome-trace [-o file] <id or file>
+
+Profiles are read from the directory of a miniprofiler.FileStore, or from a
+JSON file written by Profile.Json. list prints the most recent profiles,
+newest first. show prints a profile's steps as an indented tree with their
+custom timings. export writes a profile as chrome-trace, speedscope, pprof or
+har, as served by the results endpoint.
+*/
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+)
+
+var dir = flag.String("dir", "", "directory of a miniprofiler.FileStore")
+
+func usage() {
+	fmt.Fprintf(os.Stderr, `usage: miniprofiler [-dir dir] command [arguments]
+
+commands:
+	list [-n 20] [-name route] [-status 5xx] [-tag key:value] [-min 100ms]
+	show <id or file>
+	export -format chrome-trace|speedscope|pprof|har [-o file] <id or file>
+`)
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+	}
+	args := flag.Args()[1:]
+	var err error
+	switch flag.Arg(0) {
+	case "list":
+		err = list(args)
+	case "show":
+		err = show(args)
+	case "export":
+		err = export(args)
+	default:
+		usage()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "miniprofiler:", err)
+		os.Exit(1)
+	}
+}
+
+func store() (*miniprofiler.FileStore, error) {
+	if *dir == "" {
+		return nil, fmt.Errorf("-dir is required to read stored profiles")
+	}
+	return miniprofiler.NewFileStore(*dir), nil
+}
+
+// load returns the profile in the file named arg, or else the stored profile
+// with id arg.
+func load(arg string) (*miniprofiler.Profile, error) {
+	if b, err := ioutil.ReadFile(arg); err == nil {
+		p := miniprofiler.ProfileFromJson(b)
+		if p.Root == nil {
+			return nil, fmt.Errorf("%s: not a profile", arg)
+		}
+		return p, nil
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	s, err := store()
+	if err != nil {
+		return nil, err
+	}
+	p := s.Get(nil, arg)
+	if p == nil {
+		return nil, fmt.Errorf("%s: no such profile in %s", arg, s.Dir)
+	}
+	return p, nil
+}
+
+// tagFlags is a repeatable key:value flag.
+type tagFlags []string
+
+func (t *tagFlags) String() string     { return strings.Join(*t, ",") }
+func (t *tagFlags) Set(v string) error { *t = append(*t, v); return nil }
+
+func list(args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	n := fs.Int("n", 20, "maximum number of profiles to list")
+	name := fs.String("name", "", "list only profiles with this name, such as a route")
+	status := fs.String("status", "", "list only responses with this status code or class, such as 404 or 5xx")
+	min := fs.Duration("min", 0, "list only profiles that took at least this long")
+	var tags tagFlags
+	fs.Var(&tags, "tag", "list only profiles with this tag, as key or key:value (repeatable)")
+	fs.Parse(args)
+	s, err := store()
+	if err != nil {
+		return err
+	}
+	f := miniprofiler.Filter{
+		Name:        *name,
+		Status:      *status,
+		Tags:        tags,
+		MinDuration: *min,
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tSTARTED\tMS\tSTATUS\tNAME\tTAGS")
+	for _, p := range s.ListFilter(nil, *n, f) {
+		fmt.Fprintf(w, "%s\t%s\t%.1f\t%s\t%s\t%s\n", p.Id, p.Start().Format("2006-01-02 15:04:05"),
+			p.DurationMilliseconds, statusText(p.StatusCode), p.Name, formatTags(p.Tags))
+	}
+	return w.Flush()
+}
+
+func formatTags(tags map[string]string) string {
+	var l []string
+	for k, v := range tags {
+		l = append(l, k+":"+v)
+	}
+	sort.Strings(l)
+	return strings.Join(l, " ")
+}
+
+func statusText(code int) string {
+	if code == 0 {
+		return "-"
+	}
+	return strconv.Itoa(code)
+}
+
+func show(args []string) error {
+	fs := flag.NewFlagSet("show", flag.ExitOnError)
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		usage()
+	}
+	p, err := load(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	printProfile(os.Stdout, p)
+	return nil
+}
+
+// printProfile writes a text report of p to w.
+func printProfile(w io.Writer, p *miniprofiler.Profile) {
+	fmt.Fprintf(w, "%s (%s)\n", p.Name, p.Id)
+	fmt.Fprintf(w, "%s on %s, %.1f ms", p.Start().Format(time.RFC3339), p.MachineName, p.DurationMilliseconds)
+	if p.StatusCode != 0 {
+		fmt.Fprintf(w, ", %d", p.StatusCode)
+		if p.ContentType != "" {
+			fmt.Fprintf(w, " %s", p.ContentType)
+		}
+		fmt.Fprintf(w, ", %d bytes", p.ResponseSize)
+	}
+	fmt.Fprintln(w)
+	if len(p.Tags) > 0 {
+		fmt.Fprintf(w, "tags: %s\n", formatTags(p.Tags))
+	}
+	for _, r := range p.Regressions {
+		fmt.Fprintf(w, "regressed: %s %s %.1f (baseline %.1f)\n", r.Kind, r.Name, r.Value, r.Baseline)
+	}
+	fmt.Fprintln(w)
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "ms\tstart\t")
+	printTiming(tw, p.Root, 0)
+	if len(p.ClientSteps) > 0 {
+		fmt.Fprintln(tw, "\t\t client")
+		for _, s := range p.ClientSteps {
+			printTiming(tw, s, 1)
+		}
+	}
+	tw.Flush()
+	if p.ClientTimings != nil && len(p.ClientTimings.Timings) > 0 {
+		fmt.Fprintln(w)
+		tw = tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintln(tw, "ms\tstart\t client event")
+		for _, c := range p.ClientTimings.Timings {
+			fmt.Fprintf(tw, "%d\t%d\t %s\n", c.Duration, c.Start, c.Name)
+		}
+		tw.Flush()
+	}
+}
+
+func printTiming(w io.Writer, t *miniprofiler.Timing, depth int) {
+	indent := strings.Repeat("  ", depth)
+	fmt.Fprintf(w, "%.1f\t+%.1f\t %s%s\n", t.DurationMilliseconds, t.StartMilliseconds, indent, t.Name)
+	var callTypes []string
+	for callType := range t.CustomTimings {
+		callTypes = append(callTypes, callType)
+	}
+	sort.Strings(callTypes)
+	for _, callType := range callTypes {
+		for _, ct := range t.CustomTimings[callType] {
+			command := strings.Join(strings.Fields(ct.CommandString), " ")
+			fmt.Fprintf(w, "%.1f\t+%.1f\t %s  %s %s: %s\n", ct.DurationMilliseconds, ct.StartMilliseconds,
+				indent, callType, ct.ExecuteType, command)
+		}
+	}
+	for _, c := range t.Children {
+		printTiming(w, c, depth+1)
+	}
+}
+
+func export(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	formats := miniprofiler.ExportFormats()
+	format := fs.String("format", "", strings.Join(formats, ", "))
+	out := fs.String("o", "", "output file (default standard output)")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		usage()
+	}
+	if i := sort.SearchStrings(formats, *format); i == len(formats) || formats[i] != *format {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+	p, err := load(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := miniprofiler.Export(&buf, p, *format); err != nil {
+		return err
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(buf.Bytes())
+		return err
+	}
+	return ioutil.WriteFile(*out, buf.Bytes(), 0644)
+}
@@ -25,7 +25,7 @@ func Index(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) {
 }
 
 func main() {
-	miniprofiler.TrivialMilliseconds = 0
+	miniprofiler.DefaultProfiler.TrivialMilliseconds = 0
 	http.Handle("/", miniprofiler.NewHandler(Index))
 	fmt.Println("serving")
 	http.ListenAndServe(":8080", nil)
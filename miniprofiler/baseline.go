@@ -0,0 +1,262 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+package miniprofiler
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Baseline is the expected performance of a route, taken from a profile with
+// BaselineFromProfile or from a route's statistics with Stats.Baseline.
+type Baseline struct {
+	Route string
+
+	// Id is the id of the profile the baseline was taken from, or "" if it
+	// was taken from statistics.
+	Id string `json:",omitempty"`
+
+	DurationMilliseconds float64
+
+	// CustomTimingCounts is the number of custom timings of each call type,
+	// such as "sql", per request.
+	CustomTimingCounts map[string]float64
+
+	// Steps is the total duration of the steps with each name per request.
+	Steps map[string]float64
+}
+
+// BaselineFromProfile returns a Baseline of p's route, which is p's name.
+func BaselineFromProfile(p *Profile) *Baseline {
+	b := &Baseline{
+		Route:                p.Name,
+		Id:                   p.Id,
+		DurationMilliseconds: p.DurationMilliseconds,
+		CustomTimingCounts:   make(map[string]float64),
+		Steps:                make(map[string]float64),
+	}
+	p.Walk(func(t *Timing) {
+		if t != p.Root {
+			b.Steps[t.Name] += t.DurationMilliseconds
+		}
+		for callType, cts := range t.CustomTimings {
+			b.CustomTimingCounts[callType] += float64(len(cts))
+		}
+	})
+	return b
+}
+
+// Regression is a way in which a profile performed worse than the baseline of
+// its route.
+type Regression struct {
+	// Kind is "duration" for the profile's duration, "custom-timings" for the
+	// number of custom timings of the call type Name, or "step" for the
+	// duration of the steps named Name.
+	Kind string
+	Name string `json:",omitempty"`
+
+	Baseline float64
+	Value    float64
+}
+
+// Baselines holds a Baseline per route and checks profiles against them. Set
+// Profiler.Baselines to a Baselines to record the regressions of each
+// profile in Profile.Regressions at Finalize. The thresholds may be changed
+// before the Baselines is used, but not after.
+type Baselines struct {
+	// Duration is the fraction by which a profile's duration may exceed the
+	// baseline's before it is a regression, such as 0.5 for 50% slower. If
+	// negative, durations are not checked.
+	Duration float64
+
+	// CustomTimings is the number of custom timings of each call type a
+	// profile may have beyond the baseline's before it is a regression. If
+	// negative, custom timings are not checked.
+	CustomTimings int
+
+	// Steps maps the names of the steps to check to the fraction by which
+	// their total duration may exceed the baseline's, as for Duration.
+	Steps map[string]float64
+
+	// MinDelta is the least increase in a duration that is a regression, so
+	// short requests and steps are not flagged for noise.
+	MinDelta time.Duration
+
+	mu        sync.Mutex
+	baselines map[string]*Baseline
+}
+
+// NewBaselines returns an empty Baselines that flags profiles 50% and 10ms
+// slower than their baseline, or with any additional custom timings.
+func NewBaselines() *Baselines {
+	return &Baselines{
+		Duration:  0.5,
+		MinDelta:  10 * time.Millisecond,
+		baselines: make(map[string]*Baseline),
+	}
+}
+
+// Set makes b the baseline of its route, replacing any previous one.
+func (bs *Baselines) Set(b *Baseline) {
+	bs.mu.Lock()
+	bs.baselines[b.Route] = b
+	bs.mu.Unlock()
+}
+
+// Get returns the baseline of route, or nil if it has none.
+func (bs *Baselines) Get(route string) *Baseline {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	return bs.baselines[route]
+}
+
+// Delete removes the baseline of route.
+func (bs *Baselines) Delete(route string) {
+	bs.mu.Lock()
+	delete(bs.baselines, route)
+	bs.mu.Unlock()
+}
+
+// List returns the baselines, ordered by route.
+func (bs *Baselines) List() []*Baseline {
+	bs.mu.Lock()
+	l := make([]*Baseline, 0, len(bs.baselines))
+	for _, b := range bs.baselines {
+		l = append(l, b)
+	}
+	bs.mu.Unlock()
+	sort.Slice(l, func(i, j int) bool {
+		return l[i].Route < l[j].Route
+	})
+	return l
+}
+
+// Check returns the regressions of p against the baseline of its route. It
+// returns nil if there are none or the route has no baseline.
+func (bs *Baselines) Check(p *Profile) []Regression {
+	base := bs.Get(p.Name)
+	if base == nil || p.Root == nil || base.Id == p.Id {
+		return nil
+	}
+	cur := BaselineFromProfile(p)
+	minDelta := float64(bs.MinDelta) / float64(time.Millisecond)
+	slower := func(value, baseline, fraction float64) bool {
+		return value-baseline >= minDelta && value > baseline*(1+fraction)
+	}
+	var regs []Regression
+	if bs.Duration >= 0 && slower(cur.DurationMilliseconds, base.DurationMilliseconds, bs.Duration) {
+		regs = append(regs, Regression{
+			Kind:     "duration",
+			Baseline: base.DurationMilliseconds,
+			Value:    cur.DurationMilliseconds,
+		})
+	}
+	if bs.CustomTimings >= 0 {
+		var callTypes []string
+		for callType := range cur.CustomTimingCounts {
+			callTypes = append(callTypes, callType)
+		}
+		sort.Strings(callTypes)
+		for _, callType := range callTypes {
+			n, b := cur.CustomTimingCounts[callType], base.CustomTimingCounts[callType]
+			if n > b+float64(bs.CustomTimings) {
+				regs = append(regs, Regression{
+					Kind:     "custom-timings",
+					Name:     callType,
+					Baseline: b,
+					Value:    n,
+				})
+			}
+		}
+	}
+	var steps []string
+	for name := range bs.Steps {
+		steps = append(steps, name)
+	}
+	sort.Strings(steps)
+	for _, name := range steps {
+		d, b := cur.Steps[name], base.Steps[name]
+		if slower(d, b, bs.Steps[name]) {
+			regs = append(regs, Regression{
+				Kind:     "step",
+				Name:     name,
+				Baseline: b,
+				Value:    d,
+			})
+		}
+	}
+	return regs
+}
+
+// KeepRegressions returns true if the profile regressed against its
+// baseline. It requires Profiler.Baselines.
+func KeepRegressions(p *Profile) bool {
+	return len(p.Regressions) > 0
+}
+
+// baseline serves the baselines as JSON. POST with id sets the baseline of
+// the profile's route to that profile; POST with route sets it to the
+// route's statistics; DELETE with route removes it.
+func (mp *Profiler) baseline(w http.ResponseWriter, r *http.Request) {
+	if mp.Baselines == nil {
+		http.Error(w, "", http.StatusNotFound)
+		return
+	}
+	id := r.FormValue("id")
+	route := r.FormValue("route")
+	var v interface{}
+	switch {
+	case r.Method == "POST" && id != "":
+		p := mp.Get(r, id)
+		if p == nil {
+			http.Error(w, "", http.StatusNotFound)
+			return
+		}
+		b := BaselineFromProfile(p)
+		mp.Baselines.Set(b)
+		v = b
+	case r.Method == "POST" && route != "":
+		var b *Baseline
+		if mp.Stats != nil {
+			b = mp.Stats.Baseline(route)
+		}
+		if b == nil {
+			http.Error(w, "", http.StatusNotFound)
+			return
+		}
+		mp.Baselines.Set(b)
+		v = b
+	case r.Method == "DELETE" && route != "":
+		mp.Baselines.Delete(route)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case r.Method == "GET" || r.Method == "HEAD":
+		v = mp.Baselines.List()
+	default:
+		http.Error(w, "", http.StatusBadRequest)
+		return
+	}
+	j, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(j)
+}
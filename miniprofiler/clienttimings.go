@@ -0,0 +1,215 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+package miniprofiler
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math"
+	"mime"
+	"net/http"
+	"sort"
+)
+
+// maxClientSteps is the number of client steps, including nested ones, kept
+// per profile.
+const maxClientSteps = 1000
+
+// maxClientResources is the number of Resource Timing entries kept per
+// profile, so pages with many resources do not bloat it.
+const maxClientResources = 250
+
+// NavigationTiming is a PerformanceNavigationTiming entry (Navigation Timing
+// Level 2). Times are in milliseconds since the start of navigation; those of
+// events that did not happen are 0.
+type NavigationTiming struct {
+	Type            string // navigate, reload, back_forward or prerender
+	NextHopProtocol string // such as h2 or http/1.1
+	TransferSize    int64
+	EncodedBodySize int64
+	DecodedBodySize int64
+
+	RedirectStart              float64
+	RedirectEnd                float64
+	FetchStart                 float64
+	DomainLookupStart          float64
+	DomainLookupEnd            float64
+	ConnectStart               float64
+	SecureConnectionStart      float64
+	ConnectEnd                 float64
+	RequestStart               float64
+	ResponseStart              float64
+	ResponseEnd                float64
+	DomInteractive             float64
+	DomContentLoadedEventStart float64
+	DomContentLoadedEventEnd   float64
+	DomComplete                float64
+	LoadEventStart             float64
+	LoadEventEnd               float64
+}
+
+// ResourceTiming is a PerformanceResourceTiming entry: a resource fetched by
+// the page, such as a script, image or XHR.
+type ResourceTiming struct {
+	Name            string // URL
+	InitiatorType   string // such as script, img or fetch
+	NextHopProtocol string
+	StartTime       float64
+	Duration        float64
+	TransferSize    int64
+	DecodedBodySize int64
+}
+
+// clientTimingsJSON is the JSON posted by the includes with the results
+// request, as the clientTimings form value or as the request body. Field
+// names match those of the browser's performance entries.
+type clientTimingsJSON struct {
+	Navigation             *NavigationTiming
+	FirstContentfulPaint   float64
+	LargestContentfulPaint float64
+	Resources              []*ResourceTiming
+}
+
+// addClientTimingsJSON adds the timings posted as JSON with r to ct, which is
+// created if nil. It returns ct unchanged if none were posted.
+func addClientTimingsJSON(r *http.Request, ct *ClientTimings) *ClientTimings {
+	b := []byte(r.FormValue("clientTimings"))
+	if t, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); t == "application/json" && r.Body != nil {
+		b, _ = ioutil.ReadAll(io.LimitReader(r.Body, 1<<20))
+	}
+	if len(b) == 0 {
+		return ct
+	}
+	var j clientTimingsJSON
+	if err := json.Unmarshal(b, &j); err != nil || j.Navigation == nil {
+		return ct
+	}
+	if ct == nil {
+		ct = new(ClientTimings)
+	}
+	ct.Navigation = j.Navigation
+	ct.FirstContentfulPaint = j.FirstContentfulPaint
+	ct.LargestContentfulPaint = j.LargestContentfulPaint
+	ct.Resources = j.Resources
+	sort.SliceStable(ct.Resources, func(i, k int) bool {
+		return ct.Resources[i].StartTime < ct.Resources[k].StartTime
+	})
+	if len(ct.Resources) > maxClientResources {
+		ct.Resources = ct.Resources[:maxClientResources]
+	}
+	if len(ct.Timings) == 0 {
+		ct.Timings = j.Navigation.timings()
+	}
+	return ct
+}
+
+// timings returns n as the ClientTiming pairs of the legacy
+// window.performance.timing fields, for the consumers of Timings.
+func (n *NavigationTiming) timings() []*ClientTiming {
+	var l []*ClientTiming
+	add := func(name string, start, end float64) {
+		if start <= 0 {
+			return
+		}
+		d := int64(-1)
+		if end >= start {
+			d = round(end - start)
+		}
+		l = append(l, &ClientTiming{Name: name, Start: round(start), Duration: d})
+	}
+	add("Redirect", n.RedirectStart, n.RedirectEnd)
+	add("Fetch", n.FetchStart, -1)
+	add("Domain Lookup", n.DomainLookupStart, n.DomainLookupEnd)
+	add("Connect", n.ConnectStart, n.ConnectEnd)
+	add("Secure Connection", n.SecureConnectionStart, -1)
+	add("Request", n.RequestStart, -1)
+	add("Response", n.ResponseStart, n.ResponseEnd)
+	add("Dom Content Loaded Event", n.DomContentLoadedEventStart, n.DomContentLoadedEventEnd)
+	add("Dom Complete", n.DomComplete, -1)
+	add("Load Event", n.LoadEventStart, n.LoadEventEnd)
+	sort.SliceStable(l, func(i, j int) bool {
+		return l[i].Start < l[j].Start
+	})
+	return l
+}
+
+func round(ms float64) int64 {
+	return int64(math.Floor(ms + 0.5))
+}
+
+// clientSteps appends the steps posted as JSON by MiniProfiler.step to the
+// ClientSteps of the profile with the id form value.
+func (mp *Profiler) clientSteps(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "", http.StatusMethodNotAllowed)
+		return
+	}
+	var steps []*Timing
+	if err := json.NewDecoder(io.LimitReader(r.Body, 1<<20)).Decode(&steps); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	p := mp.update(r, r.FormValue("id"), func(p *Profile) bool {
+		n := 0
+		for _, s := range p.ClientSteps {
+			n += countSteps(s)
+		}
+		for _, s := range steps {
+			if s == nil {
+				continue
+			}
+			c := cleanClientStep(s)
+			if n+c > maxClientSteps {
+				break
+			}
+			p.ClientSteps = append(p.ClientSteps, s)
+			n += c
+		}
+		return true
+	})
+	if p == nil {
+		http.Error(w, "", http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// cleanClientStep gives t and its children new ids, removes any custom
+// timings and nil children, and returns the number of steps.
+func cleanClientStep(t *Timing) int {
+	t.Id = newGuid()
+	t.CustomTimings = nil
+	n := 1
+	children := t.Children[:0]
+	for _, c := range t.Children {
+		if c != nil {
+			n += cleanClientStep(c)
+			children = append(children, c)
+		}
+	}
+	t.Children = children
+	return n
+}
+
+// countSteps returns the number of steps in t, including t.
+func countSteps(t *Timing) int {
+	n := 1
+	for _, c := range t.Children {
+		n += countSteps(c)
+	}
+	return n
+}
@@ -16,40 +16,78 @@ const contextKey ctxKey = 0
 // request's context, rather than as function arguments.
 // This approach is more compatible with standard net/http Handlers.
 type ContextHandler struct {
-	f    http.Handler
-	name string
+	f        http.Handler
+	name     string
+	profiler *Profiler
 }
 
-// NewContextHandler creates a ContextHandler to wrap the given http.HandlerFunc.
+// NewContextHandler creates a ContextHandler to wrap the given http.HandlerFunc
+// using DefaultProfiler.
 // A profiler will be added to the request Context, and can be retreived with
 // miniprofiler.GetTimer(r)
 func NewContextHandler(f http.Handler) http.Handler {
+	return DefaultProfiler.NewContextHandler(f)
+}
+
+// NewNamedContextHandler creates a ContextHandler to wrap the given http.HandlerFunc
+// using DefaultProfiler.
+// A profiler will be added to the request Context, and can be retreived with
+// miniprofiler.GetTimer(r)
+func NewNamedContextHandler(f http.Handler, name string) http.Handler {
+	return DefaultProfiler.NewNamedContextHandler(f, name)
+}
+
+// NewContextHandler creates a ContextHandler to wrap the given http.HandlerFunc.
+// A profiler will be added to the request Context, and can be retreived with
+// miniprofiler.GetTimer(r)
+func (mp *Profiler) NewContextHandler(f http.Handler) http.Handler {
 	return ContextHandler{
-		f: f,
+		f:        f,
+		profiler: mp,
 	}
 }
 
 // NewNamedContextHandler creates a ContextHandler to wrap the given http.HandlerFunc.
 // A profiler will be added to the request Context, and can be retreived with
 // miniprofiler.GetTimer(r)
-func NewNamedContextHandler(f http.Handler, name string) http.Handler {
+func (mp *Profiler) NewNamedContextHandler(f http.Handler, name string) http.Handler {
 	return ContextHandler{
-		f:    f,
-		name: name,
+		f:        f,
+		name:     name,
+		profiler: mp,
 	}
 }
 
 func (h ContextHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.profiler.isResource(r) {
+		h.profiler.ServeHTTP(w, r)
+		return
+	}
 	fname := h.name
 	if fname == "" {
 		fname = FuncName(h.f)
 	}
-	p := NewProfile(w, r, fname)
-	ctx := context.WithValue(r.Context(), contextKey, p)
-	h.f.ServeHTTP(w, r.WithContext(ctx))
+	p := h.profiler.NewProfile(w, r, fname)
+	h.f.ServeHTTP(p.w, p.WithRequest(r))
 	p.Finalize()
 }
 
+// WithRequest returns a shallow copy of r whose context carries p, so it can
+// be retrieved with GetTimer. The copy becomes the request p is finalized
+// with, so pass it on to the handler to let Finalize see any routing
+// information recorded on it.
+// For use only by miniprofiler extensions.
+func (p *Profile) WithRequest(r *http.Request) *http.Request {
+	p.r = r.WithContext(NewContext(r.Context(), p))
+	return p.r
+}
+
+// NewContext returns a copy of ctx that carries t, which can be retrieved
+// with GetTimerFromContext.
+func NewContext(ctx context.Context, t Timer) context.Context {
+	return context.WithValue(ctx, contextKey, t)
+}
+
 // GetTimer will retreive the timer from the given http request's context.
 // If the request has not been wrapped by a ContextHandler, nil will be returned.
 func GetTimer(r *http.Request) Timer {
@@ -59,9 +97,6 @@ func GetTimer(r *http.Request) Timer {
 // GetTimerFromContext will retreive the timer from the given context.
 // If the given context has not been wrapped by a ContextHandler, nil will be returned.
 func GetTimerFromContext(ctx context.Context) Timer {
-	val := ctx.Value(contextKey)
-	if val == nil {
-		return nil
-	}
-	return val.(*Profile)
+	t, _ := ctx.Value(contextKey).(Timer)
+	return t
 }
@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofiler
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+)
+
+// ProfileDiff is the difference between two profiles, A and B, such as
+// before and after a change.
+type ProfileDiff struct {
+	A, B                 string // profile ids
+	AName, BName         string
+	ADuration, BDuration float64
+	DeltaMilliseconds    float64
+	CustomTimingCounts   map[string][2]int // call type to counts in A and B
+	Root                 *TimingDiff
+}
+
+// TimingDiff is the difference between steps matched by their path of names
+// from the root. Steps with the same name under the same parent are matched
+// in order.
+type TimingDiff struct {
+	Name string
+
+	// Status is "added" if the step is only in B, "removed" if it is only in
+	// A, and "" otherwise.
+	Status string
+
+	ADuration, BDuration float64
+	DeltaMilliseconds    float64
+
+	// CustomTimings are the custom timings of the step whose count differs
+	// between A and B, matched by call type, execute type and command.
+	CustomTimings []CustomTimingDiff
+
+	Children []*TimingDiff
+}
+
+type CustomTimingDiff struct {
+	CallType      string
+	ExecuteType   string
+	CommandString string
+	ACount        int
+	BCount        int
+}
+
+// DiffProfiles returns the structural difference between a and b.
+func DiffProfiles(a, b *Profile) *ProfileDiff {
+	d := &ProfileDiff{
+		A:                  a.Id,
+		B:                  b.Id,
+		AName:              a.Name,
+		BName:              b.Name,
+		ADuration:          a.DurationMilliseconds,
+		BDuration:          b.DurationMilliseconds,
+		DeltaMilliseconds:  b.DurationMilliseconds - a.DurationMilliseconds,
+		CustomTimingCounts: make(map[string][2]int),
+	}
+	a.Walk(func(t *Timing) {
+		for callType, cts := range t.CustomTimings {
+			c := d.CustomTimingCounts[callType]
+			c[0] += len(cts)
+			d.CustomTimingCounts[callType] = c
+		}
+	})
+	b.Walk(func(t *Timing) {
+		for callType, cts := range t.CustomTimings {
+			c := d.CustomTimingCounts[callType]
+			c[1] += len(cts)
+			d.CustomTimingCounts[callType] = c
+		}
+	})
+	d.Root = diffTimings(a.Root, b.Root)
+	return d
+}
+
+// diffTimings returns the difference between a and b, either of which may be
+// nil.
+func diffTimings(a, b *Timing) *TimingDiff {
+	d := new(TimingDiff)
+	var aChildren, bChildren []*Timing
+	type ctKey struct {
+		callType, executeType, command string
+	}
+	counts := make(map[ctKey][2]int)
+	var keys []ctKey
+	count := func(t *Timing, i int) {
+		for callType, cts := range t.CustomTimings {
+			for _, ct := range cts {
+				k := ctKey{callType, ct.ExecuteType, ct.CommandString}
+				c, ok := counts[k]
+				if !ok {
+					keys = append(keys, k)
+				}
+				c[i]++
+				counts[k] = c
+			}
+		}
+	}
+	if a != nil {
+		a.Lock()
+		d.Name = a.Name
+		d.ADuration = a.DurationMilliseconds
+		aChildren = a.Children
+		count(a, 0)
+		a.Unlock()
+	}
+	if b != nil {
+		b.Lock()
+		d.Name = b.Name
+		d.BDuration = b.DurationMilliseconds
+		bChildren = b.Children
+		count(b, 1)
+		b.Unlock()
+	}
+	switch {
+	case a == nil:
+		d.Status = "added"
+	case b == nil:
+		d.Status = "removed"
+	}
+	d.DeltaMilliseconds = d.BDuration - d.ADuration
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].callType < keys[j].callType
+	})
+	for _, k := range keys {
+		if c := counts[k]; c[0] != c[1] {
+			d.CustomTimings = append(d.CustomTimings, CustomTimingDiff{
+				CallType:      k.callType,
+				ExecuteType:   k.executeType,
+				CommandString: k.command,
+				ACount:        c[0],
+				BCount:        c[1],
+			})
+		}
+	}
+
+	// Match children by name and occurrence, in the order they appear in A
+	// and then those only in B.
+	type childKey struct {
+		name string
+		n    int
+	}
+	bByKey := make(map[childKey]*Timing)
+	seen := make(map[string]int)
+	for _, c := range bChildren {
+		bByKey[childKey{c.Name, seen[c.Name]}] = c
+		seen[c.Name]++
+	}
+	seen = make(map[string]int)
+	for _, c := range aChildren {
+		k := childKey{c.Name, seen[c.Name]}
+		seen[c.Name]++
+		d.Children = append(d.Children, diffTimings(c, bByKey[k]))
+		delete(bByKey, k)
+	}
+	seen = make(map[string]int)
+	for _, c := range bChildren {
+		k := childKey{c.Name, seen[c.Name]}
+		seen[c.Name]++
+		if _, ok := bByKey[k]; ok {
+			d.Children = append(d.Children, diffTimings(nil, c))
+		}
+	}
+	return d
+}
+
+// diffRow is a TimingDiff flattened for display.
+type diffRow struct {
+	*TimingDiff
+	Depth int
+}
+
+func (d *ProfileDiff) rows() []diffRow {
+	var rows []diffRow
+	var add func(t *TimingDiff, depth int)
+	add = func(t *TimingDiff, depth int) {
+		rows = append(rows, diffRow{t, depth})
+		for _, c := range t.Children {
+			add(c, depth+1)
+		}
+	}
+	if d.Root != nil {
+		add(d.Root, 0)
+	}
+	return rows
+}
+
+// resultsDiff serves the difference between the profiles with ids a and b, as
+// HTML or, with format=json, as JSON.
+func (mp *Profiler) resultsDiff(w http.ResponseWriter, r *http.Request) {
+	a := mp.Get(r, r.FormValue("a"))
+	b := mp.Get(r, r.FormValue("b"))
+	if a == nil || b == nil {
+		http.Error(w, "", http.StatusNotFound)
+		return
+	}
+	d := DiffProfiles(a, b)
+	if r.FormValue("format") == "json" {
+		j, err := json.Marshal(d)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(j)
+		return
+	}
+	v := map[string]interface{}{
+		"path":    mp.urlPath(r),
+		"version": Version,
+		"diff":    d,
+		"rows":    d.rows(),
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if err := resultsDiffTmpl.Execute(w, v); err != nil {
+		log.Print(err)
+	}
+}
+
+// signedMs formats a duration delta in milliseconds with its sign.
+func signedMs(ms float64) string {
+	return fmt.Sprintf("%+.1f", ms)
+}
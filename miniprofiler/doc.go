@@ -23,21 +23,233 @@ To use this package, change your HTTP handler functions to use this signature:
 
 Register them in the usual way, wrapping them with NewHandler.
 
+Configuration is held by a Profiler. The package-level functions, such as
+NewHandler, use miniprofiler.DefaultProfiler, whose fields may be changed
+before serving requests.
+
 By default, all requests are profiled. This should be changed to profile
-only developer requests. Set miniprofiler.Enable to a function that returns
-true if profiling is enabled. It might resemble this:
+only developer requests. Set Enable to a function that returns true if
+profiling is enabled. It might resemble this:
 
-	miniprofiler.Enable = func(r *http.Request) bool {
+	miniprofiler.DefaultProfiler.Enable = func(r *http.Request) bool {
 		return isUserAuthenticated(r)
 	}
 
 By default, profile results are stored in memory in a concurrent-safe
 data structure. To store in redis, memcache, or something else, set
-miniprofiler.Store and miniprofiler.Get to functions to back the profile
-data. The key is Profile.Id.
+Store and Get to functions to back the profile data. The key is Profile.Id.
+FileStore stores profiles as JSON files in a directory instead, where the
+miniprofiler command can inspect them from a terminal:
+
+	fs := miniprofiler.NewFileStore("/var/lib/myapp/profiles")
+	mp.Store, mp.Get, mp.List = fs.Store, fs.Get, fs.List
+
+	$ miniprofiler -dir /var/lib/myapp/profiles list -status 5xx
+	$ miniprofiler -dir /var/lib/myapp/profiles show <id>
+
+The miniprofiler-viewer command serves the results pages for such a
+directory from a separate process, such as one listening only on an internal
+network.
 
 Send output of t.Includes() to your HTML (it is empty if Enable returns
-false).
+false). Alternatively, set AutoIncludes to true to have it added before
+</body> of every HTML response.
+
+Handlers created by NewHandler and NewContextHandler serve the profiler's
+resources under its Path, /mini-profiler-resources/ by default, themselves.
+If they are not mounted at the root, mount the Profiler there too:
+
+	http.Handle(miniprofiler.DefaultProfiler.Path, miniprofiler.DefaultProfiler)
+
+Behind a reverse proxy that serves the application under a sub-path, set
+ForwardedPrefix so URLs include the proxy's X-Forwarded-Prefix header.
+
+Profilers
+
+To run differently configured profilers in one program, such as one for a
+public site and one for an admin API, create each with New. Each has its own
+in-memory store and handlers:
+
+	admin := miniprofiler.New()
+	admin.Path = "/admin/mini-profiler-resources/"
+	admin.ShowTrivial = true
+	mux.Handle("/admin/", admin.NewContextHandler(adminHandler))
+
+Production
+
+Enable is called once per request. Policies can be composed to keep the
+overhead low when running in production. This profiles requests from
+developers carrying a cookie, plus a 1% sample of everything else, limited to
+10 profiles per minute for each URL path:
+
+	developer := miniprofiler.EnableCookie("profile", developerSecret)
+	miniprofiler.DefaultProfiler.Enable = miniprofiler.EnableAny(
+		developer,
+		miniprofiler.EnableEvery(
+			miniprofiler.EnableSample(0.01),
+			miniprofiler.EnableRateLimit(10, nil),
+		),
+	)
+	miniprofiler.DefaultProfiler.Authorize = developer
+
+EnableHeader and EnableIP are also available.
+
+EnableRateLimit counts requests in one-minute windows, starting afresh each
+minute. A window tracks at most 10000 routes; requests for further routes are
+not profiled until the next window.
+
+Profiling a request and showing its profile are separate decisions. Enable
+decides which requests are profiled; Authorize decides which clients see the
+popup, the X-MiniProfiler-Ids header and the profiler's pages. Both default to
+EnableAll, which suits development. Whenever Enable profiles requests from the
+public, set Authorize to identify developers, as above, or every sampled
+visitor is shown the popup.
+
+Keep is called at Finalize, once the duration, response status code and
+custom timings are known, and decides whether a profile is stored. To profile
+every request but keep only those over a latency target, that failed, or that
+made too many queries:
+
+	miniprofiler.DefaultProfiler.Enable = miniprofiler.EnableAll
+	miniprofiler.DefaultProfiler.Authorize = miniprofiler.EnableIP("10.0.0.0/8")
+	miniprofiler.DefaultProfiler.Keep = miniprofiler.KeepAny(
+		miniprofiler.KeepSlow(500*time.Millisecond),
+		miniprofiler.KeepErrors,
+		miniprofiler.KeepCustomTimings("sql", 50),
+	)
+
+Any func(*miniprofiler.Profile) bool may be used, such as one inspecting
+Profile.StatusCode or Profile.CustomTimingCount. Authorize keeps the popup to
+the internal network here; without it, every visitor would be shown the popup
+for profiles that Keep may then discard.
+
+Server-Timing
+
+Set ServerTiming to true to add a Server-Timing header to profiled
+responses, which shows the total duration, top-level steps and custom timing
+totals (sql, redis, ...) in browser developer tools and to API clients that
+never render the popup. Like the popup, it is only sent to clients that
+Authorize allows. Profile.ServerTiming returns the same value for use
+elsewhere.
+
+Results
+
+Stored profiles are listed, newest first, at results-index under Path
+(/mini-profiler-resources/results-index by default) along with their response
+status code, content type and size. Add ?status=5xx (or a code such as 404)
+to show only failed requests, ?tag=tenant:acme to show only profiles with that
+tag, or ?name=/users/{id} to show only one route. The same list is available
+as JSON at results-list. The filters search only the 100 most recent
+profiles returned by List, so older matches are not shown. Listing requires
+List; the default lists the in-memory store.
+
+The results, stats and baseline pages and endpoints are served only to
+clients for which Authorize returns true; others get 401 Unauthorized. When
+Enable samples or profiles everyone, set Authorize, or every visitor may list
+the stored profiles.
+
+Two stored profiles can be compared at results-diff?a=<id>&b=<id>, for
+example the same route before and after a change. Steps are matched by their
+name path; the comparison shows each step's duration change, steps and custom
+timings only in one of the profiles, and changes in custom timing counts. Add
+&format=json for the comparison as JSON.
+
+Statistics
+
+Set Stats to aggregate stored profiles by route:
+
+	miniprofiler.DefaultProfiler.Stats = miniprofiler.NewStats()
+
+stats-index under Path then lists each route's request count, mean and
+percentile durations, average custom timing counts and durations, and slowest
+steps, slowest routes first, to show which endpoint to look at first. The same
+statistics are available as JSON at stats-list.
+
+Statistics cover the 1000 most recent profiles of each route, keeping the
+duration, the counts and durations of up to 8 custom timing call types, and
+the 10 slowest steps of each. Up to 1000 routes are tracked; the least
+recently profiled route is dropped to make room for a new one.
+
+Baselines
+
+Set Baselines to flag profiles that performed worse than a baseline of their
+route:
+
+	mp.Baselines = miniprofiler.NewBaselines()
+	mp.Baselines.Steps = map[string]float64{"render": 0.2}
+
+A route's baseline is set by POSTing to baseline?id=<id> under Path with a
+profile of it, or to baseline?route=<name> with the route's average from
+Stats. It is removed with DELETE baseline?route=<name>, and GET baseline lists
+them. Baselines.Set sets one from code. Each later profile of the route is
+then checked for a duration, custom timing count or duration of the steps in
+Baselines.Steps above the thresholds of Baselines, and what regressed is
+recorded in Profile.Regressions. Regressed profiles are marked in the popup,
+and the regressions are in the profile's JSON at results?id=<id>, so smoke
+tests can fail on them. KeepRegressions stores only regressed profiles.
+
+Export
+
+A stored profile can be downloaded for other tools by adding a format to its
+results URL, such as results?id=...&format=chrome-trace:
+
+	chrome-trace  Chrome Trace Event JSON, for Perfetto (ui.perfetto.dev) and chrome://tracing
+	speedscope    speedscope evented JSON, for www.speedscope.app
+	pprof         pprof profile.proto, for go tool pprof, with steps as frames
+	har           HTTP Archive 1.2 with client timings and server steps, for HAR viewers
+
+Client timings
+
+The popup's script posts the page's browser timings to the results endpoint
+when it fetches the profile, and they are stored in Profile.ClientTimings. In
+browsers that support them, these include the Navigation Timing Level 2
+entry, the first and largest contentful paint times, and the Resource Timing
+entries of the page's scripts, styles, images and XHRs, which are listed in
+the popup. Other clients can post the same data as JSON, with field names as
+in the browser's performance entries, to results?id=<id>:
+
+	{"navigation": {...}, "firstContentfulPaint": 412.5, "largestContentfulPaint": 980.1, "resources": [{...}]}
+
+Client steps
+
+Work done in the browser, such as rendering in a single-page application, can
+be timed with MiniProfiler.step from the page's JavaScript:
+
+	MiniProfiler.step('render grid', function () {
+		grid.render();
+	});
+
+Steps may be nested, and a step whose function returns a promise lasts until
+it settles. Finished steps are posted to client-steps under Path, which
+appends them to the ClientSteps of the page's profile, and they are shown in
+the popup's timings under a "client" step, timed from the start of
+navigation.
+
+Tags and metadata
+
+Handlers can attach information about the request to its profile, which is
+shown in the popup and stored with it. The Timers of this package implement
+Tagger:
+
+	if tg, ok := t.(miniprofiler.Tagger); ok {
+		tg.AddTag("tenant", tenant.Name)
+		tg.SetMetadata("flags", enabledFlags)
+	}
+
+Tags are strings and can be filtered on in the results index. Metadata can be
+any value that encoding/json can encode.
+
+Route names
+
+Profiles are named by URL path unless a name is given, so /users/1 and
+/users/2 show up separately. Set RouteName to name them by the route template
+that matched instead, such as "/users/{id}":
+
+	miniprofiler.DefaultProfiler.RouteName = miniprofiler.ServeMuxRouteName
+
+ServeMuxRouteName requires Go 1.23 and a ServeMux wrapped by
+NewContextHandler. The middleware in the miniprofiler_chi and miniprofiler_mux
+packages, and the gocraft/web adapter, name profiles by route themselves.
 
 Step
 
@@ -92,7 +304,7 @@ This is a small example using this package.
 
 Configuration
 
-Refer to the variables section of the documentation: http://godoc.org/github.com/MiniProfiler/go/miniprofiler#pkg-variables.
+Refer to the Profiler type in the documentation: http://godoc.org/github.com/MiniProfiler/go/miniprofiler#Profiler.
 
 Other implementations and resources: http://miniprofiler.com.
 
@@ -112,6 +324,14 @@ Traffic: http://godoc.org/github.com/MiniProfiler/go/miniprofiler_traffic
 
 gocraft/web: http://godoc.org/github.com/MiniProfiler/go/miniprofiler_gocraftweb
 
+chi: http://godoc.org/github.com/MiniProfiler/go/miniprofiler_chi
+
+gorilla/mux: http://godoc.org/github.com/MiniProfiler/go/miniprofiler_mux
+
+gin: http://godoc.org/github.com/MiniProfiler/go/miniprofiler_gin
+
+echo: http://godoc.org/github.com/MiniProfiler/go/miniprofiler_echo
+
 RPCs
 
 Various RPCs have explicit support.
@@ -119,5 +339,19 @@ Various RPCs have explicit support.
 Redis: http://godoc.org/github.com/MiniProfiler/go/redis
 
 SQL: http://godoc.org/github.com/MiniProfiler/go/sql
+
+Exporters
+
+Profiles can be exported to other systems with Profiler.OnFinalize.
+
+OpenTelemetry: http://godoc.org/github.com/MiniProfiler/go/otel
+
+Prometheus: http://godoc.org/github.com/MiniProfiler/go/prometheus
+
+Testing
+
+The miniprofilertest package runs handlers with httptest and checks their
+profiles, such as their query counts, in tests:
+http://godoc.org/github.com/MiniProfiler/go/miniprofilertest.
 */
 package miniprofiler
@@ -0,0 +1,229 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofiler
+
+import (
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// EnableAny returns an Enable function that returns true if any of fs do.
+func EnableAny(fs ...func(*http.Request) bool) func(*http.Request) bool {
+	return func(r *http.Request) bool {
+		for _, f := range fs {
+			if f(r) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// EnableEvery returns an Enable function that returns true if all of fs do.
+// The functions are evaluated in order and evaluation stops at the first
+// false, so place rate limits last to avoid spending them on requests that
+// would be rejected anyway.
+func EnableEvery(fs ...func(*http.Request) bool) func(*http.Request) bool {
+	return func(r *http.Request) bool {
+		for _, f := range fs {
+			if !f(r) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// EnableSample returns an Enable function that profiles a random fraction of
+// requests. rate is between 0 (none) and 1 (all).
+func EnableSample(rate float64) func(*http.Request) bool {
+	return func(r *http.Request) bool {
+		return rnd.Float64() < rate
+	}
+}
+
+// EnableRateLimit returns an Enable function that profiles at most n requests
+// per minute for each route. key names the route of a request; if nil, the
+// URL path is used. Counts are kept for at most 10000 routes each minute;
+// requests for other routes are not profiled until the next minute.
+func EnableRateLimit(n int, key func(*http.Request) string) func(*http.Request) bool {
+	if key == nil {
+		key = func(r *http.Request) string {
+			return r.URL.Path
+		}
+	}
+	l := &rateLimiter{
+		n:      n,
+		key:    key,
+		counts: make(map[string]int),
+	}
+	return l.allow
+}
+
+// rateLimitKeys bounds the routes an EnableRateLimit policy counts each
+// minute, so requests for many distinct URL paths cannot grow it without
+// limit.
+const rateLimitKeys = 10000
+
+type rateLimiter struct {
+	n   int
+	key func(*http.Request) string
+
+	sync.Mutex
+	minute int64
+	counts map[string]int
+}
+
+func (l *rateLimiter) allow(r *http.Request) bool {
+	k := l.key(r)
+	minute := time.Now().Unix() / 60
+	l.Lock()
+	defer l.Unlock()
+	if minute != l.minute {
+		l.minute = minute
+		l.counts = make(map[string]int)
+	}
+	c, ok := l.counts[k]
+	if c >= l.n || !ok && len(l.counts) >= rateLimitKeys {
+		return false
+	}
+	l.counts[k]++
+	return true
+}
+
+// EnableCookie returns an Enable function that returns true if the request has
+// the named cookie. If value is not empty, the cookie must also have that
+// value.
+func EnableCookie(name, value string) func(*http.Request) bool {
+	return func(r *http.Request) bool {
+		c, err := r.Cookie(name)
+		if err != nil {
+			return false
+		}
+		return value == "" || c.Value == value
+	}
+}
+
+// EnableHeader returns an Enable function that returns true if the request has
+// the named header. If value is not empty, the header must also have that
+// value.
+func EnableHeader(name, value string) func(*http.Request) bool {
+	return func(r *http.Request) bool {
+		v, present := r.Header[http.CanonicalHeaderKey(name)]
+		if !present {
+			return false
+		}
+		return value == "" || (len(v) > 0 && v[0] == value)
+	}
+}
+
+// EnableIP returns an Enable function that returns true if the request's
+// remote address is in one of the given networks. Each entry is either an IP
+// address or a CIDR network, such as "10.0.0.0/8". It panics if an entry
+// cannot be parsed.
+//
+// Only http.Request.RemoteAddr is considered. Behind a proxy, use
+// EnableHeader or a custom function that trusts the proxy's headers instead.
+func EnableIP(addrs ...string) func(*http.Request) bool {
+	var nets []*net.IPNet
+	for _, a := range addrs {
+		if ip := net.ParseIP(a); ip != nil {
+			bits := 8 * len(ip)
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 32
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(a)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, n)
+	}
+	return func(r *http.Request) bool {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		ip := net.ParseIP(host)
+		if ip == nil {
+			return false
+		}
+		for _, n := range nets {
+			if n.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// KeepAll returns true. It is the default for Keep.
+func KeepAll(p *Profile) bool {
+	return true
+}
+
+// KeepAny returns a Keep function that returns true if any of fs do.
+func KeepAny(fs ...func(*Profile) bool) func(*Profile) bool {
+	return func(p *Profile) bool {
+		for _, f := range fs {
+			if f(p) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// KeepSlow returns a Keep function that stores only profiles that took at
+// least d. Combined with an Enable function that profiles every request, it
+// retroactively keeps the slow ones and discards the rest at Finalize.
+func KeepSlow(d time.Duration) func(*Profile) bool {
+	ms := float64(d) / float64(time.Millisecond)
+	return func(p *Profile) bool {
+		return p.DurationMilliseconds >= ms
+	}
+}
+
+// KeepErrors returns true if the response had a 5xx status code.
+func KeepErrors(p *Profile) bool {
+	return p.StatusCode >= 500
+}
+
+// KeepStatus returns a Keep function that stores only profiles whose response
+// had one of the given status codes.
+func KeepStatus(codes ...int) func(*Profile) bool {
+	return func(p *Profile) bool {
+		for _, c := range codes {
+			if p.StatusCode == c {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// KeepCustomTimings returns a Keep function that stores only profiles with at
+// least n custom timings of callType, such as "sql".
+func KeepCustomTimings(callType string, n int) func(*Profile) bool {
+	return func(p *Profile) bool {
+		return p.CustomTimingCount(callType) >= n
+	}
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofiler
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestEnableRateLimit(t *testing.T) {
+	minute := time.Now().Unix() / 60
+	enable := EnableRateLimit(2, nil)
+	allowed := func(path string) bool {
+		return enable(httptest.NewRequest("GET", path, nil))
+	}
+	var got []bool
+	for _, path := range []string{"/a", "/a", "/a", "/b"} {
+		got = append(got, allowed(path))
+	}
+	// Fill the window with routes; a new one is then refused while a
+	// counted one still has its allowance.
+	for i := 2; i < rateLimitKeys; i++ {
+		allowed(fmt.Sprintf("/%d", i))
+	}
+	got = append(got, allowed("/new"), allowed("/b"))
+	if time.Now().Unix()/60 != minute {
+		t.Skip("crossed a rate limit window")
+	}
+	want := []bool{true, true, false, true, false, true}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
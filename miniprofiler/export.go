@@ -0,0 +1,291 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofiler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+)
+
+// exporter writes a Profile in a format understood by other tools.
+type exporter struct {
+	contentType string
+	ext         string // file name extension, without the dot
+	write       func(*Profile, io.Writer) error
+}
+
+// exporters are the formats served by results?id=...&format=<name>.
+var exporters = map[string]exporter{
+	"chrome-trace": {"application/json", "json", (*Profile).WriteChromeTrace},
+	"speedscope":   {"application/json", "json", (*Profile).WriteSpeedscope},
+	"pprof":        {"application/octet-stream", "pb.gz", (*Profile).WritePprof},
+	"har":          {"application/json", "har", (*Profile).WriteHAR},
+}
+
+// ExportFormats returns the names of the formats Export supports, sorted.
+func ExportFormats() []string {
+	var formats []string
+	for name := range exporters {
+		formats = append(formats, name)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+// Export writes p to w in format, one of ExportFormats, as served by the
+// results endpoint. p is locked while it is written, as other requests may be
+// updating it concurrently.
+func Export(w io.Writer, p *Profile, format string) error {
+	e, ok := exporters[format]
+	if !ok {
+		return fmt.Errorf("miniprofiler: unknown export format %q", format)
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return e.write(p, w)
+}
+
+// serveExport writes p in format as a download.
+func serveExport(w http.ResponseWriter, p *Profile, format string) {
+	e, ok := exporters[format]
+	if !ok {
+		http.Error(w, "unknown format: "+format, http.StatusBadRequest)
+		return
+	}
+	var buf bytes.Buffer
+	if err := Export(&buf, p, format); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", e.contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s-%s.%s\"", p.Id, format, e.ext))
+	w.Write(buf.Bytes())
+}
+
+// traceEvent is a Chrome Trace Event.
+type traceEvent struct {
+	Name string                 `json:"name"`
+	Cat  string                 `json:"cat,omitempty"`
+	Ph   string                 `json:"ph"`
+	Ts   float64                `json:"ts"`
+	Dur  float64                `json:"dur,omitempty"`
+	Pid  int                    `json:"pid"`
+	Tid  int                    `json:"tid"`
+	Args map[string]interface{} `json:"args,omitempty"`
+}
+
+// WriteChromeTrace writes p in the Chrome Trace Event format, which can be
+// opened with Perfetto (ui.perfetto.dev) or chrome://tracing. Steps and
+// custom timings are duration events nested as in the profile. Steps that
+// overlap their siblings, because they ran concurrently, are placed on
+// separate tracks.
+func (p *Profile) WriteChromeTrace(w io.Writer) error {
+	t := newChromeTrace(p)
+	events := []traceEvent{{
+		Name: "process_name",
+		Ph:   "M",
+		Pid:  1,
+		Args: map[string]interface{}{"name": p.Name},
+	}}
+	for tid := 1; tid <= t.tracks; tid++ {
+		name := "request"
+		if tid > 1 {
+			name = fmt.Sprintf("concurrent %d", tid-1)
+		}
+		events = append(events, traceEvent{
+			Name: "thread_name",
+			Ph:   "M",
+			Pid:  1,
+			Tid:  tid,
+			Args: map[string]interface{}{"name": name},
+		})
+	}
+	return json.NewEncoder(w).Encode(map[string]interface{}{
+		"traceEvents":     append(events, t.events...),
+		"displayTimeUnit": "ms",
+		"otherData": map[string]interface{}{
+			"id":          p.Id,
+			"machineName": p.MachineName,
+			"started":     p.Started,
+		},
+	})
+}
+
+// chromeTrace lays out Timings as trace events on tracks.
+type chromeTrace struct {
+	events []traceEvent
+	tracks int
+}
+
+// newChromeTrace lays out the Timings of p.
+func newChromeTrace(p *Profile) *chromeTrace {
+	t := &chromeTrace{tracks: 1}
+	if p.Root != nil {
+		t.place(p.Root, 1)
+	}
+	return t
+}
+
+// traceItem is a step or custom timing to be placed on a track.
+type traceItem struct {
+	start, duration float64
+	timing          *Timing
+	callType        string
+	custom          *CustomTiming
+}
+
+// place adds t and its descendants to track tid. Children that start before the previous child on the track has ended
+// are placed on new tracks.
+func (c *chromeTrace) place(t *Timing, tid int) {
+	c.events = append(c.events, traceEvent{
+		Name: t.Name,
+		Cat:  "step",
+		Ph:   "X",
+		Ts:   t.StartMilliseconds * 1000,
+		Dur:  t.DurationMilliseconds * 1000,
+		Pid:  1,
+		Tid:  tid,
+	})
+	var items []traceItem
+	t.Lock()
+	for _, child := range t.Children {
+		items = append(items, traceItem{start: child.StartMilliseconds, duration: child.DurationMilliseconds, timing: child})
+	}
+	for callType, cts := range t.CustomTimings {
+		for _, ct := range cts {
+			items = append(items, traceItem{start: ct.StartMilliseconds, duration: ct.DurationMilliseconds, callType: callType, custom: ct})
+		}
+	}
+	t.Unlock()
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].start < items[j].start
+	})
+	end := t.StartMilliseconds
+	for _, item := range items {
+		itemTid := tid
+		if item.start < end {
+			c.tracks++
+			itemTid = c.tracks
+		} else {
+			end = item.start + item.duration
+		}
+		if item.timing != nil {
+			c.place(item.timing, itemTid)
+			continue
+		}
+		c.events = append(c.events, traceEvent{
+			Name: item.callType + " " + item.custom.ExecuteType,
+			Cat:  item.callType,
+			Ph:   "X",
+			Ts:   item.start * 1000,
+			Dur:  item.duration * 1000,
+			Pid:  1,
+			Tid:  itemTid,
+			Args: map[string]interface{}{
+				"command":    item.custom.CommandString,
+				"stackTrace": item.custom.StackTraceSnippet,
+			},
+		})
+	}
+}
+
+// WriteSpeedscope writes p in speedscope's evented format, which can be
+// opened with speedscope (www.speedscope.app). Each track of WriteChromeTrace
+// becomes a separate profile in the file, as speedscope requires the events
+// of a profile to nest.
+func (p *Profile) WriteSpeedscope(w io.Writer) error {
+	t := newChromeTrace(p)
+	type frame struct {
+		Name string `json:"name"`
+	}
+	type event struct {
+		Type  string  `json:"type"`
+		Frame int     `json:"frame"`
+		At    float64 `json:"at"`
+	}
+	type profile struct {
+		Type       string  `json:"type"`
+		Name       string  `json:"name"`
+		Unit       string  `json:"unit"`
+		StartValue float64 `json:"startValue"`
+		EndValue   float64 `json:"endValue"`
+		Events     []event `json:"events"`
+	}
+	var frames []frame
+	frameIds := make(map[string]int)
+	profiles := make([]profile, t.tracks)
+	for i := range profiles {
+		name := "request"
+		if i > 0 {
+			name = fmt.Sprintf("concurrent %d", i)
+		}
+		profiles[i] = profile{
+			Type:     "evented",
+			Name:     name,
+			Unit:     "milliseconds",
+			EndValue: p.DurationMilliseconds,
+			Events:   []event{},
+		}
+	}
+	// Each track's events nest and are in start order, so they can be
+	// opened and closed with a stack.
+	stacks := make([][]event, t.tracks)
+	closeUntil := func(tid int, until float64) {
+		stack := stacks[tid]
+		for len(stack) > 0 {
+			top := stack[len(stack)-1]
+			if top.At > until {
+				break
+			}
+			profiles[tid].Events = append(profiles[tid].Events, top)
+			stack = stack[:len(stack)-1]
+		}
+		stacks[tid] = stack
+	}
+	for _, e := range t.events {
+		tid := e.Tid - 1
+		start, end := e.Ts/1000, (e.Ts+e.Dur)/1000
+		closeUntil(tid, start)
+		if n := len(stacks[tid]); n > 0 && end > stacks[tid][n-1].At {
+			// Clamp rounding errors so children end within their parent.
+			end = stacks[tid][n-1].At
+		}
+		id, ok := frameIds[e.Name]
+		if !ok {
+			id = len(frames)
+			frameIds[e.Name] = id
+			frames = append(frames, frame{Name: e.Name})
+		}
+		profiles[tid].Events = append(profiles[tid].Events, event{"O", id, start})
+		stacks[tid] = append(stacks[tid], event{"C", id, end})
+	}
+	for tid := range stacks {
+		closeUntil(tid, math.Inf(1))
+	}
+	return json.NewEncoder(w).Encode(map[string]interface{}{
+		"$schema":  "https://www.speedscope.app/file-format-schema.json",
+		"name":     p.Name,
+		"exporter": "miniprofiler " + Version,
+		"shared":   map[string]interface{}{"frames": frames},
+		"profiles": profiles,
+	})
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+package miniprofiler
+
+import (
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FileStore stores profiles as JSON files named <id>.json in a directory, so
+// they outlive the process and can be read by others, such as the
+// miniprofiler command. Like MemoryStore, it does not expire profiles.
+type FileStore struct {
+	Dir string
+}
+
+// NewFileStore returns a FileStore in dir, which is created on first use.
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{Dir: dir}
+}
+
+// Store stores a profile. It is suitable for Profiler.Store.
+func (f *FileStore) Store(r *http.Request, p *Profile) {
+	if err := os.MkdirAll(f.Dir, 0755); err != nil {
+		log.Print(err)
+		return
+	}
+	// Write to a temporary file first, so readers never see a partial one.
+	tmp, err := ioutil.TempFile(f.Dir, ".profile")
+	if err != nil {
+		log.Print(err)
+		return
+	}
+	_, err = tmp.Write(p.Json())
+	if err == nil {
+		// TempFile creates files only their owner can read; let a viewer
+		// running as another user read them too.
+		err = tmp.Chmod(0644)
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), f.path(p.Id))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		log.Print(err)
+	}
+}
+
+// Get fetches a stored profile. It is suitable for Profiler.Get.
+func (f *FileStore) Get(r *http.Request, id string) *Profile {
+	if !validId(id) {
+		return nil
+	}
+	b, err := ioutil.ReadFile(f.path(id))
+	if err != nil {
+		return nil
+	}
+	return ProfileFromJson(b)
+}
+
+// List lists stored profiles, newest first. It is suitable for Profiler.List.
+func (f *FileStore) List(r *http.Request, n int) []*Profile {
+	return f.ListFilter(r, n, Filter{})
+}
+
+// ListFilter lists up to n stored profiles that filter matches, newest
+// first. It reads profiles only until it has found n.
+func (f *FileStore) ListFilter(r *http.Request, n int, filter Filter) []*Profile {
+	infos, err := ioutil.ReadDir(f.Dir)
+	if err != nil {
+		return nil
+	}
+	sort.SliceStable(infos, func(i, j int) bool {
+		return infos[i].ModTime().After(infos[j].ModTime())
+	})
+	var l []*Profile
+	for _, fi := range infos {
+		if len(l) >= n {
+			break
+		}
+		id := strings.TrimSuffix(fi.Name(), ".json")
+		if fi.IsDir() || id == fi.Name() {
+			continue
+		}
+		if p := f.Get(r, id); p != nil && filter.Match(p) {
+			l = append(l, p)
+		}
+	}
+	return l
+}
+
+func (f *FileStore) path(id string) string {
+	return filepath.Join(f.Dir, id+".json")
+}
+
+// validId returns true if id can be used as a file name: it is not empty and
+// has only letters, digits and dashes.
+func validId(id string) bool {
+	if id == "" {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
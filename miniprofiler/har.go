@@ -0,0 +1,254 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofiler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// harTimings are the timings of a HAR entry, in milliseconds. -1 means not
+// available.
+type harTimings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+	SSL     float64 `json:"ssl"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// harStep is a step or custom timing in the _miniprofiler field of an entry.
+type harStep struct {
+	Name                 string  `json:"name"`
+	Depth                int     `json:"depth"`
+	StartMilliseconds    float64 `json:"startMilliseconds"`
+	DurationMilliseconds float64 `json:"durationMilliseconds"`
+	CallType             string  `json:"callType,omitempty"`
+	ExecuteType          string  `json:"executeType,omitempty"`
+	CommandString        string  `json:"commandString,omitempty"`
+}
+
+// WriteHAR writes p as an HTTP Archive (HAR 1.2) with a single page and
+// entry, which browsers' developer tools and HAR viewers can open. The entry's
+// timings come from the client timings, if the browser reported them, and the
+// server's steps and custom timings are in its _miniprofiler field.
+func (p *Profile) WriteHAR(w io.Writer) error {
+	started := p.Start().UTC().Format(time.RFC3339Nano)
+	method, rawURL := "GET", ""
+	if p.Root != nil {
+		if i := strings.Index(p.Root.Name, " "); i >= 0 {
+			method, rawURL = p.Root.Name[:i], p.Root.Name[i+1:]
+		}
+	}
+	var query []harNameValue
+	if u, err := url.Parse(rawURL); err == nil {
+		for k, vs := range u.Query() {
+			for _, v := range vs {
+				query = append(query, harNameValue{k, v})
+			}
+		}
+	}
+	sort.Slice(query, func(i, j int) bool {
+		return query[i].Name < query[j].Name
+	})
+	var headers []harNameValue
+	for k, vs := range p.ResponseHeaders {
+		for _, v := range vs {
+			headers = append(headers, harNameValue{k, v})
+		}
+	}
+	sort.Slice(headers, func(i, j int) bool {
+		return headers[i].Name < headers[j].Name
+	})
+	timings := p.harTimings()
+	total := 0.0
+	for _, t := range []float64{timings.Blocked, timings.DNS, timings.Connect, timings.Send, timings.Wait, timings.Receive} {
+		if t > 0 {
+			total += t
+		}
+	}
+	steps := harSteps(p)
+	entry := map[string]interface{}{
+		"pageref":         p.Id,
+		"startedDateTime": started,
+		"time":            total,
+		"request": map[string]interface{}{
+			"method":      method,
+			"url":         rawURL,
+			"httpVersion": "HTTP/1.1",
+			"cookies":     []harNameValue{},
+			"headers":     []harNameValue{},
+			"queryString": nonNil(query),
+			"headersSize": -1,
+			"bodySize":    -1,
+		},
+		"response": map[string]interface{}{
+			"status":      p.StatusCode,
+			"statusText":  http.StatusText(p.StatusCode),
+			"httpVersion": "HTTP/1.1",
+			"cookies":     []harNameValue{},
+			"headers":     nonNil(headers),
+			"content": map[string]interface{}{
+				"size":     p.ResponseSize,
+				"mimeType": p.ContentType,
+			},
+			"redirectURL": p.ResponseHeaders.Get("Location"),
+			"headersSize": -1,
+			"bodySize":    p.ResponseSize,
+		},
+		"cache":   map[string]interface{}{},
+		"timings": timings,
+		"_miniprofiler": map[string]interface{}{
+			"id":                   p.Id,
+			"machineName":          p.MachineName,
+			"durationMilliseconds": p.DurationMilliseconds,
+			"steps":                steps,
+		},
+	}
+	page := map[string]interface{}{
+		"startedDateTime": started,
+		"id":              p.Id,
+		"title":           p.Name,
+		"pageTimings": map[string]float64{
+			"onContentLoad": p.clientTimingStart("Dom Content Loaded Event"),
+			"onLoad":        p.clientTimingStart("Load Event"),
+		},
+	}
+	return json.NewEncoder(w).Encode(map[string]interface{}{
+		"log": map[string]interface{}{
+			"version": "1.2",
+			"creator": map[string]string{
+				"name":    "miniprofiler",
+				"version": Version,
+			},
+			"pages":   []interface{}{page},
+			"entries": []interface{}{entry},
+		},
+	})
+}
+
+// harTimings returns the entry timings from the client timings, or only the
+// server's duration as the wait if there are none.
+func (p *Profile) harTimings() harTimings {
+	t := harTimings{
+		Blocked: -1,
+		DNS:     -1,
+		Connect: -1,
+		SSL:     -1,
+		Wait:    p.DurationMilliseconds,
+	}
+	if p.ClientTimings == nil {
+		return t
+	}
+	t.DNS = p.clientTimingDuration("Domain Lookup")
+	t.Connect = p.clientTimingDuration("Connect")
+	t.SSL = p.clientTimingDuration("Secure Connection")
+	request := p.clientTimingStart("Request")
+	response := p.clientTimingStart("Response")
+	if request >= 0 && response >= request {
+		t.Wait = response - request
+	}
+	if d := p.clientTimingDuration("Response"); d >= 0 {
+		t.Receive = d
+	}
+	return t
+}
+
+// clientTiming returns the named client timing, such as "Domain Lookup", or
+// nil.
+func (p *Profile) clientTiming(name string) *ClientTiming {
+	if p.ClientTimings == nil {
+		return nil
+	}
+	for _, t := range p.ClientTimings.Timings {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+func (p *Profile) clientTimingStart(name string) float64 {
+	if t := p.clientTiming(name); t != nil {
+		return float64(t.Start)
+	}
+	return -1
+}
+
+func (p *Profile) clientTimingDuration(name string) float64 {
+	if t := p.clientTiming(name); t != nil && t.Duration >= 0 {
+		return float64(t.Duration)
+	}
+	return -1
+}
+
+// harSteps returns the steps of p and their custom timings, depth first.
+func harSteps(p *Profile) []harStep {
+	var steps []harStep
+	depths := make(map[*Timing]int)
+	p.Walk(func(t *Timing) {
+		depth := depths[t]
+		steps = append(steps, harStep{
+			Name:                 t.Name,
+			Depth:                depth,
+			StartMilliseconds:    t.StartMilliseconds,
+			DurationMilliseconds: t.DurationMilliseconds,
+		})
+		var callTypes []string
+		for callType := range t.CustomTimings {
+			callTypes = append(callTypes, callType)
+		}
+		sort.Strings(callTypes)
+		for _, callType := range callTypes {
+			for _, ct := range t.CustomTimings[callType] {
+				steps = append(steps, harStep{
+					Name:                 callType + " " + ct.ExecuteType,
+					Depth:                depth + 1,
+					StartMilliseconds:    ct.StartMilliseconds,
+					DurationMilliseconds: ct.DurationMilliseconds,
+					CallType:             callType,
+					ExecuteType:          ct.ExecuteType,
+					CommandString:        ct.CommandString,
+				})
+			}
+		}
+		for _, c := range t.Children {
+			depths[c] = depth + 1
+		}
+	})
+	return steps
+}
+
+// nonNil returns l, or an empty list if it is nil, as HAR requires lists.
+func nonNil(l []harNameValue) []harNameValue {
+	if l == nil {
+		return []harNameValue{}
+	}
+	return l
+}
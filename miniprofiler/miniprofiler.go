@@ -34,36 +34,13 @@ import (
 )
 
 var (
-	// Enable returns true if the request should be profiled.
-	Enable func(*http.Request) bool = EnableAll
-
-	// Store stores the Profile by its Id field.
-	Store func(*http.Request, *Profile) = StoreMemory
-
-	// Get retrieves a Profile by its Id field.
-	Get func(*http.Request, string) *Profile = GetMemory
-
-	// MachineName returns the machine name to display.
-	// The default is to use the machine's hostname.
-	MachineName func() string = Hostname
-
-	// Valid positions: left, right, bottomleft, bottomright
-	Position = "left"
-
-	ShowTrivial         = false
-	ShowChildren        = false
-	MaxTracesToShow     = 15
-	ShowControls        = true
-	ToggleShortcut      = "Alt+P"
-	StartHidden         = false
-	TrivialMilliseconds = 12.0
-
 	Version = "3.0.12"
 
 	staticFiles map[string][]byte
 )
 
 const (
+	// PATH is the default Profiler.Path.
 	PATH = "/mini-profiler-resources/"
 
 	clientTimingsPrefix = "clientPerformance[timing]["
@@ -74,43 +51,36 @@ var (
 	fsHandler = http.FileServer(webFS)
 )
 
-func init() {
-	http.Handle(PATH, http.StripPrefix(PATH, http.HandlerFunc(MiniProfilerHandler)))
-}
-
-// miniProfilerHandler serves requests to the /mini-profiler-resources/
-// path. For use only by miniprofiler helper libraries.
+// MiniProfilerHandler serves requests to the /mini-profiler-resources/
+// path for DefaultProfiler. For use only by miniprofiler helper libraries.
 func MiniProfilerHandler(w http.ResponseWriter, r *http.Request) {
-	if r.URL.Path == "results" {
-		results(w, r)
-	} else {
-		fsHandler.ServeHTTP(w, r)
-	}
+	DefaultProfiler.ServeHTTP(w, r)
 }
 
-func results(w http.ResponseWriter, r *http.Request) {
+func (mp *Profiler) results(w http.ResponseWriter, r *http.Request) {
 	id := r.FormValue("id")
 	isPopup := r.FormValue("popup") == "1"
-	p := Get(r, id)
+	p := mp.update(r, id, func(p *Profile) bool {
+		if p.ClientTimings != nil {
+			return false
+		}
+		p.ClientTimings = addClientTimingsJSON(r, getClientTimings(r))
+		return p.ClientTimings != nil
+	})
 	if p == nil {
 		http.Error(w, "", http.StatusNotFound)
 		return
 	}
 
-	needsSave := false
-	if p.ClientTimings == nil {
-		p.ClientTimings = getClientTimings(r)
-		if p.ClientTimings != nil {
-			needsSave = true
-		}
-	}
-
-	if needsSave {
-		Store(r, p)
+	if format := r.FormValue("format"); format != "" {
+		serveExport(w, p, format)
+		return
 	}
 
-	var j []byte
+	// Lock p, as another request may be updating it concurrently.
+	p.mu.Lock()
 	j, err := json.Marshal(p)
+	p.mu.Unlock()
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -122,9 +92,9 @@ func results(w http.ResponseWriter, r *http.Request) {
 		v := map[string]interface{}{
 			"name":     p.Name,
 			"duration": p.DurationMilliseconds,
-			"path":     PATH,
+			"path":     mp.urlPath(r),
 			"json":     template.JS(j),
-			"includes": p.Includes(),
+			"includes": p.includes(r),
 			"version":  Version,
 		}
 
@@ -133,6 +103,156 @@ func results(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// maxListProfiles is the number of profiles fetched for the results index.
+// The index's filters apply to these, so they don't find older profiles.
+const maxListProfiles = 100
+
+// listProfile is the results index entry for a Profile.
+type listProfile struct {
+	Id                   string
+	Name                 string
+	Started              int64
+	MachineName          string
+	DurationMilliseconds float64
+	StatusCode           int
+	ResponseSize         int64
+	ContentType          string
+	Tags                 map[string]string `json:",omitempty"`
+}
+
+// listProfiles returns the stored profiles matching the form values of r:
+// status (a code such as "404", or a class such as "5xx"), tag (key:value, or
+// just key to match any value; may be repeated), name (the profile name) and
+// last-id (only profiles newer than it).
+func (mp *Profiler) listProfiles(r *http.Request) []listProfile {
+	f := Filter{
+		Name:   r.FormValue("name"),
+		Status: r.FormValue("status"),
+		Tags:   r.Form["tag"],
+	}
+	lastId := r.FormValue("last-id")
+	var l []listProfile
+	for _, p := range mp.List(r, maxListProfiles) {
+		if lastId != "" && p.Id == lastId {
+			break
+		}
+		if !f.Match(p) {
+			continue
+		}
+		l = append(l, listProfile{
+			Id:                   p.Id,
+			Name:                 p.Name,
+			Started:              p.Started,
+			MachineName:          p.MachineName,
+			DurationMilliseconds: p.DurationMilliseconds,
+			StatusCode:           p.StatusCode,
+			ResponseSize:         p.ResponseSize,
+			ContentType:          p.ContentType,
+			Tags:                 p.Tags,
+		})
+	}
+	return l
+}
+
+// Filter selects profiles, as the results index does with its form values.
+// The zero Filter matches every profile.
+type Filter struct {
+	// Name is the profile name, such as a route.
+	Name string
+	// Status is a status code such as "404", or a class such as "5xx".
+	Status string
+	// Tags are key:value, or just key to match any value. Profiles must
+	// have all of them.
+	Tags []string
+	// MinDuration is the shortest profile duration matched.
+	MinDuration time.Duration
+}
+
+// Match reports whether f selects p.
+func (f Filter) Match(p *Profile) bool {
+	if f.Name != "" && p.Name != f.Name {
+		return false
+	}
+	if p.DurationMilliseconds < float64(f.MinDuration)/float64(time.Millisecond) {
+		return false
+	}
+	return statusFilter(f.Status)(p.StatusCode) && hasTags(p, f.Tags)
+}
+
+// hasTags reports whether p has all of tags, each of which is key:value or
+// just key.
+func hasTags(p *Profile, tags []string) bool {
+	for _, t := range tags {
+		if t == "" {
+			continue
+		}
+		key, value := t, ""
+		i := strings.Index(t, ":")
+		if i >= 0 {
+			key, value = t[:i], t[i+1:]
+		}
+		v, present := p.Tags[key]
+		if !present || (i >= 0 && v != value) {
+			return false
+		}
+	}
+	return true
+}
+
+// statusFilter returns a function matching status codes against s, which is
+// empty (match all), a code such as "404", or a class such as "5xx".
+func statusFilter(s string) func(int) bool {
+	s = strings.ToLower(s)
+	if len(s) == 3 && strings.HasSuffix(s, "xx") {
+		if c, err := strconv.Atoi(s[:1]); err == nil {
+			return func(code int) bool {
+				return code/100 == c
+			}
+		}
+	}
+	if c, err := strconv.Atoi(s); err == nil {
+		return func(code int) bool {
+			return code == c
+		}
+	}
+	return func(int) bool {
+		return true
+	}
+}
+
+func (mp *Profiler) resultsList(w http.ResponseWriter, r *http.Request) {
+	if mp.List == nil {
+		http.Error(w, "", http.StatusNotFound)
+		return
+	}
+	j, err := json.Marshal(mp.listProfiles(r))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(j)
+}
+
+func (mp *Profiler) resultsIndex(w http.ResponseWriter, r *http.Request) {
+	if mp.List == nil {
+		http.Error(w, "", http.StatusNotFound)
+		return
+	}
+	v := map[string]interface{}{
+		"path":     mp.urlPath(r),
+		"version":  Version,
+		"status":   r.FormValue("status"),
+		"tag":      r.FormValue("tag"),
+		"name":     r.FormValue("name"),
+		"profiles": mp.listProfiles(r),
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if err := resultsIndexTmpl.Execute(w, v); err != nil {
+		log.Print(err)
+	}
+}
+
 func getClientTimings(r *http.Request) *ClientTimings {
 	var navigationStart int64
 	if i, err := strconv.ParseInt(r.FormValue(clientTimingsPrefix+"navigationStart]"), 10, 64); err != nil {
@@ -146,7 +266,6 @@ func getClientTimings(r *http.Request) *ClientTimings {
 		ct.RedirectCount = i
 	}
 
-	r.ParseForm()
 	clientPerf := make(map[string]ClientTiming)
 	for k, v := range r.Form {
 		if len(v) < 1 || !strings.HasPrefix(k, clientTimingsPrefix) {
@@ -230,29 +349,40 @@ func static(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Includes renders the JavaScript includes for this request, if enabled.
+// Includes renders the JavaScript includes for this request, if it is
+// profiled and Authorize allows the client to see it.
 func (p *Profile) Includes() template.HTML {
-	if !Enable(p.r) {
+	if !p.show {
+		return ""
+	}
+	return p.includes(p.r)
+}
+
+// includes renders the JavaScript includes for a page served in response to
+// r, if p is enabled.
+func (p *Profile) includes(r *http.Request) template.HTML {
+	if p.Root == nil {
 		return ""
 	}
 
 	current := p.Id
 	authorized := true
+	mp := p.profiler
 
 	v := map[string]interface{}{
 		"ids":                 current,
-		"path":                PATH,
+		"path":                mp.urlPath(r),
 		"version":             Version,
-		"position":            Position,
-		"showTrivial":         ShowTrivial,
-		"showChildren":        ShowChildren,
-		"maxTracesToShow":     MaxTracesToShow,
-		"showControls":        ShowControls,
+		"position":            mp.Position,
+		"showTrivial":         mp.ShowTrivial,
+		"showChildren":        mp.ShowChildren,
+		"maxTracesToShow":     mp.MaxTracesToShow,
+		"showControls":        mp.ShowControls,
 		"currentId":           current,
 		"authorized":          authorized,
-		"toggleShortcut":      ToggleShortcut,
-		"startHidden":         StartHidden,
-		"trivialMilliseconds": TrivialMilliseconds,
+		"toggleShortcut":      mp.ToggleShortcut,
+		"startHidden":         mp.StartHidden,
+		"trivialMilliseconds": mp.TrivialMilliseconds,
 	}
 
 	var w bytes.Buffer
@@ -264,18 +394,21 @@ func (p *Profile) Includes() template.HTML {
 }
 
 type Handler struct {
-	f func(Timer, http.ResponseWriter, *http.Request)
-	p *Profile
+	f        func(Timer, http.ResponseWriter, *http.Request)
+	p        *Profile
+	profiler *Profiler
 }
 
-// NewHandler returns a new profiled handler.
+// NewHandler returns a new profiled handler using DefaultProfiler.
 func NewHandler(f func(Timer, http.ResponseWriter, *http.Request)) Handler {
-	return Handler{
-		f: f,
-	}
+	return DefaultProfiler.NewHandler(f)
 }
 
 func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.profiler.isResource(r) {
+		h.profiler.ServeHTTP(w, r)
+		return
+	}
 	switch r.URL.Query().Get("pp") {
 	default:
 		h.ProfileRequest(w, r)
@@ -283,8 +416,8 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h Handler) ProfileRequest(w http.ResponseWriter, r *http.Request) {
-	h.p = NewProfile(w, r, FuncName(h.f))
-	h.f(h.p, w, r)
+	h.p = h.profiler.NewProfile(w, r, FuncName(h.f))
+	h.f(h.p, h.p.w, r)
 	h.p.Finalize()
 }
 
@@ -322,27 +455,48 @@ func EnableAll(r *http.Request) bool {
 	return true
 }
 
-var profiles map[string]*Profile
-var profileLock sync.Mutex
+// MemoryStore stores profiles in memory (concurrent-safe). Note that profiles
+// do not expire, so memory usage will increase until restart. It is provided
+// as an example: it is not designed for production use.
+type MemoryStore struct {
+	sync.Mutex
+	profiles map[string]*Profile
+	ids      []string
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		profiles: make(map[string]*Profile),
+	}
+}
 
-func init() {
-	profiles = make(map[string]*Profile)
+// Store stores a profile. It is suitable for Profiler.Store.
+func (m *MemoryStore) Store(r *http.Request, p *Profile) {
+	m.Lock()
+	defer m.Unlock()
+	if _, present := m.profiles[p.Id]; !present {
+		m.ids = append(m.ids, p.Id)
+	}
+	m.profiles[p.Id] = p
 }
 
-// StoreMemory stores a profile in memory (concurrent-safe). Note that profiles
-// do not expire, so memory usage will increase until restart. This function is
-// provided as an example: it is not designed for production use.
-func StoreMemory(r *http.Request, p *Profile) {
-	profileLock.Lock()
-	defer profileLock.Unlock()
-	profiles[string(p.Id)] = p
+// Get fetches a stored profile. It is suitable for Profiler.Get.
+func (m *MemoryStore) Get(r *http.Request, id string) *Profile {
+	m.Lock()
+	defer m.Unlock()
+	return m.profiles[id]
 }
 
-// GetMemory fetches a profile stored by StoreMemory (concurrent-safe).
-func GetMemory(r *http.Request, id string) *Profile {
-	profileLock.Lock()
-	defer profileLock.Unlock()
-	return profiles[id]
+// List lists stored profiles, newest first. It is suitable for Profiler.List.
+func (m *MemoryStore) List(r *http.Request, n int) []*Profile {
+	m.Lock()
+	defer m.Unlock()
+	var l []*Profile
+	for i := len(m.ids) - 1; i >= 0 && len(l) < n; i-- {
+		l = append(l, m.profiles[m.ids[i]])
+	}
+	return l
 }
 
 //go:generate esc -o static.go -pkg miniprofiler -prefix ../ui ../ui/include.partial.html ../ui/includes.css ../ui/includes.js ../ui/includes.tmpl ../ui/share.html
@@ -0,0 +1,231 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofiler
+
+import (
+	"compress/gzip"
+	"io"
+	"strings"
+	"time"
+)
+
+// WritePprof writes p as a gzipped pprof profile.proto, which can be read
+// with go tool pprof. Steps and custom timings are synthetic frames, and each
+// sample is the wall time spent in a stack of them, excluding time in
+// children, with the number of calls. Identical stacks are aggregated, so
+// views such as top show where the request's time went.
+func (p *Profile) WritePprof(w io.Writer) error {
+	b := newPprofBuilder()
+	b.add(p)
+	gw := gzip.NewWriter(w)
+	if _, err := gw.Write(b.encode(p)); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// pprofBuilder aggregates Timing stacks into pprof samples.
+type pprofBuilder struct {
+	strings   []string
+	stringIds map[string]int64
+	functions map[string]uint64 // frame name to function and location id
+	samples   []*pprofSample
+	stacks    map[string]*pprofSample
+}
+
+type pprofSample struct {
+	locations []uint64 // leaf first
+	calls     int64
+	wall      int64
+}
+
+func newPprofBuilder() *pprofBuilder {
+	return &pprofBuilder{
+		strings:   []string{""},
+		stringIds: map[string]int64{"": 0},
+		functions: make(map[string]uint64),
+		stacks:    make(map[string]*pprofSample),
+	}
+}
+
+func (b *pprofBuilder) string(s string) int64 {
+	id, ok := b.stringIds[s]
+	if !ok {
+		id = int64(len(b.strings))
+		b.stringIds[s] = id
+		b.strings = append(b.strings, s)
+	}
+	return id
+}
+
+func (b *pprofBuilder) location(name string) uint64 {
+	id, ok := b.functions[name]
+	if !ok {
+		id = uint64(len(b.functions) + 1)
+		b.functions[name] = id
+		b.string(name)
+	}
+	return id
+}
+
+// sample adds a call of wall milliseconds to the stack of frames, root first.
+func (b *pprofBuilder) sample(stack []string, wall float64) {
+	key := strings.Join(stack, "\x00")
+	s, ok := b.stacks[key]
+	if !ok {
+		s = new(pprofSample)
+		for i := len(stack) - 1; i >= 0; i-- {
+			s.locations = append(s.locations, b.location(stack[i]))
+		}
+		b.stacks[key] = s
+		b.samples = append(b.samples, s)
+	}
+	s.calls++
+	if wall > 0 {
+		s.wall += int64(wall * float64(time.Millisecond))
+	}
+}
+
+// add adds the steps and custom timings of p, each under the frames of its
+// ancestors.
+func (b *pprofBuilder) add(p *Profile) {
+	parents := make(map[*Timing][]string)
+	p.Walk(func(t *Timing) {
+		stack := parents[t]
+		stack = append(stack[:len(stack):len(stack)], t.Name)
+		self := t.DurationMilliseconds
+		for callType, cts := range t.CustomTimings {
+			for _, ct := range cts {
+				b.sample(append(stack[:len(stack):len(stack)], callType+" "+ct.ExecuteType), ct.DurationMilliseconds)
+				self -= ct.DurationMilliseconds
+			}
+		}
+		for _, c := range t.Children {
+			parents[c] = stack
+			self -= c.DurationMilliseconds
+		}
+		// Concurrent children can take longer than their parent.
+		if self < 0 {
+			self = 0
+		}
+		b.sample(stack, self)
+	})
+}
+
+// encode returns the profile.proto encoding of the samples.
+func (b *pprofBuilder) encode(p *Profile) []byte {
+	var e protoEncoder
+	valueType := func(typ, unit string) []byte {
+		var v protoEncoder
+		v.int64(1, b.string(typ))
+		v.int64(2, b.string(unit))
+		return v.b
+	}
+	e.message(1, valueType("calls", "count"))
+	e.message(1, valueType("wall", "nanoseconds"))
+	for _, s := range b.samples {
+		var v protoEncoder
+		v.uint64s(1, s.locations)
+		v.int64s(2, []int64{s.calls, s.wall})
+		e.message(2, v.b)
+	}
+	names := make([]string, len(b.functions)+1)
+	for name, id := range b.functions {
+		names[id] = name
+	}
+	for id := uint64(1); id < uint64(len(names)); id++ {
+		var line protoEncoder
+		line.uint64(1, id)
+		var loc protoEncoder
+		loc.uint64(1, id)
+		loc.message(4, line.b)
+		e.message(4, loc.b)
+	}
+	for id := uint64(1); id < uint64(len(names)); id++ {
+		var fn protoEncoder
+		fn.uint64(1, id)
+		fn.int64(2, b.string(names[id]))
+		fn.int64(3, b.string(names[id]))
+		e.message(5, fn.b)
+	}
+	period := valueType("wall", "nanoseconds")
+	comment := b.string(p.Name)
+	for _, s := range b.strings {
+		e.bytes(6, []byte(s))
+	}
+	e.int64(9, p.Start().UnixNano())
+	e.int64(10, int64(p.DurationMilliseconds*float64(time.Millisecond)))
+	e.message(11, period)
+	e.int64(12, 1)
+	e.int64s(13, []int64{comment})
+	return e.b
+}
+
+// protoEncoder writes protocol buffer fields.
+type protoEncoder struct {
+	b []byte
+}
+
+func (e *protoEncoder) varint(x uint64) {
+	for x >= 0x80 {
+		e.b = append(e.b, byte(x)|0x80)
+		x >>= 7
+	}
+	e.b = append(e.b, byte(x))
+}
+
+func (e *protoEncoder) key(field int, wireType int) {
+	e.varint(uint64(field)<<3 | uint64(wireType))
+}
+
+func (e *protoEncoder) uint64(field int, x uint64) {
+	if x == 0 {
+		return
+	}
+	e.key(field, 0)
+	e.varint(x)
+}
+
+func (e *protoEncoder) int64(field int, x int64) {
+	e.uint64(field, uint64(x))
+}
+
+func (e *protoEncoder) bytes(field int, b []byte) {
+	e.key(field, 2)
+	e.varint(uint64(len(b)))
+	e.b = append(e.b, b...)
+}
+
+func (e *protoEncoder) message(field int, b []byte) {
+	e.bytes(field, b)
+}
+
+func (e *protoEncoder) uint64s(field int, xs []uint64) {
+	var v protoEncoder
+	for _, x := range xs {
+		v.varint(x)
+	}
+	e.bytes(field, v.b)
+}
+
+func (e *protoEncoder) int64s(field int, xs []int64) {
+	var v protoEncoder
+	for _, x := range xs {
+		v.varint(uint64(x))
+	}
+	e.bytes(field, v.b)
+}
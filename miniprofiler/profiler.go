@@ -0,0 +1,275 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofiler
+
+import (
+	"hash/fnv"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Profiler is a configured profiler: which requests to profile, where to
+// store the profiles, and how to display them. Several may be used in one
+// program, each with its own store. Create one with New; fields may be
+// changed before the Profiler is used, but not after.
+//
+// The package-level functions use DefaultProfiler.
+type Profiler struct {
+	// Enable returns true if the request should be profiled. It is called
+	// once per request. See EnableAny and related functions for policies
+	// suitable for production use.
+	Enable func(*http.Request) bool
+
+	// Authorize returns true if the client that made a request may see
+	// profiles. It is checked for every profiled request, to decide whether
+	// the X-MiniProfiler-Ids header is set and Includes renders the popup,
+	// and for every request to the results, stats and baseline pages and
+	// endpoints under Path, which respond 401 Unauthorized if it returns
+	// false. The UI assets are always served. If nil, no client may see
+	// profiles. New sets it to EnableAll; set it when Enable profiles
+	// requests from the public, such as with EnableSample or EnableAll, so
+	// only developers see profiles.
+	Authorize func(*http.Request) bool
+
+	// Keep returns true if a finalized Profile should be stored. Profiles
+	// for which it returns false are discarded.
+	Keep func(*Profile) bool
+
+	// OnFinalize functions are called in order at Finalize with each profiled
+	// request and its Profile, before Keep, so they see every profile, not
+	// only those stored. They can be used to export profiles to other
+	// systems; see the otel package.
+	OnFinalize []func(*http.Request, *Profile)
+
+	// Store stores the Profile by its Id field.
+	Store func(*http.Request, *Profile)
+
+	// Get retrieves a Profile by its Id field.
+	Get func(*http.Request, string) *Profile
+
+	// List returns up to n of the most recently stored Profiles, newest
+	// first. It backs the results index; if nil, the index is unavailable.
+	List func(r *http.Request, n int) []*Profile
+
+	// Stats, if not nil, is updated with each stored profile, and its
+	// per-route statistics are served at stats-index and stats-list under
+	// Path.
+	Stats *Stats
+
+	// Baselines, if not nil, is used to record the regressions of each
+	// profile against the baseline of its route in Profile.Regressions, before
+	// OnFinalize and Keep. Baselines are listed, set and removed at baseline
+	// under Path.
+	Baselines *Baselines
+
+	// Path is the URL path under which the profiler's resources are served.
+	// It must begin and end with a slash. The default is PATH.
+	Path string
+
+	// ForwardedPrefix, if true, prepends the X-Forwarded-Prefix request
+	// header to Path in the URLs of generated pages. Set it when a reverse
+	// proxy serves the application under a sub-path that it strips before
+	// forwarding requests.
+	ForwardedPrefix bool
+
+	// RouteName returns the route template that matched the request, such as
+	// "/users/{id}", or "" if unknown. If set, it is called at Finalize and
+	// its result names the profile, so requests to the same route are
+	// grouped together. It is not used if the name was set with SetName.
+	// It is called with the request the profile was created for, so it only
+	// sees routes recorded on that request; see ServeMuxRouteName. Routers
+	// that record the route on the request they pass to their handlers, such
+	// as chi and gorilla/mux, need profiles created inside the router instead:
+	// see the miniprofiler_chi and miniprofiler_mux packages.
+	RouteName func(*http.Request) string
+
+	// MachineName returns the machine name to display.
+	// The default is to use the machine's hostname.
+	MachineName func() string
+
+	// Valid positions: left, right, bottomleft, bottomright
+	Position string
+
+	ShowTrivial         bool
+	ShowChildren        bool
+	MaxTracesToShow     int
+	ShowControls        bool
+	ToggleShortcut      string
+	StartHidden         bool
+	TrivialMilliseconds float64
+
+	// AutoIncludes, if true, adds the output of Includes before </body> in
+	// text/html responses written through NewHandler, NewContextHandler or
+	// Profile.ResponseWriter, so templates need not render it. Responses are
+	// buffered to do so; those that are flushed early or are larger than 4MB
+	// are sent unmodified.
+	AutoIncludes bool
+
+	// ServerTiming, if true, adds a Server-Timing header to responses written
+	// through NewHandler, NewContextHandler or Profile.ResponseWriter. It is
+	// computed when the header is written, so it covers the work done before
+	// then; with AutoIncludes it covers the whole request. Like the popup, it
+	// is only sent to clients that Authorize allows.
+	ServerTiming bool
+
+	// updates serialize changes to stored profiles by a hash of their id.
+	updates [16]sync.Mutex
+}
+
+// DefaultProfiler is the Profiler used by the package-level functions.
+var DefaultProfiler = New()
+
+// New returns a Profiler with the default configuration: all requests are
+// profiled and kept in its own MemoryStore.
+func New() *Profiler {
+	m := NewMemoryStore()
+	return &Profiler{
+		Enable:              EnableAll,
+		Authorize:           EnableAll,
+		Keep:                KeepAll,
+		Store:               m.Store,
+		Get:                 m.Get,
+		List:                m.List,
+		Path:                PATH,
+		MachineName:         Hostname,
+		Position:            "left",
+		MaxTracesToShow:     15,
+		ShowControls:        true,
+		ToggleShortcut:      "Alt+P",
+		TrivialMilliseconds: 12.0,
+	}
+}
+
+// ServeHTTP serves the profiler's resources: the UI assets and the results
+// pages. Mount it at Path:
+//
+//	mux.Handle(mp.Path, mp)
+//
+// Handlers created with mp's NewHandler and NewContextHandler also serve these
+// requests, so this is only needed if those are not mounted at the root.
+func (mp *Profiler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var h http.HandlerFunc
+	switch strings.TrimPrefix(r.URL.Path, mp.Path) {
+	case "results":
+		h = mp.results
+	case "results-index":
+		h = mp.resultsIndex
+	case "results-list":
+		h = mp.resultsList
+	case "client-steps":
+		h = mp.clientSteps
+	case "results-diff":
+		h = mp.resultsDiff
+	case "stats-index":
+		h = mp.statsIndex
+	case "stats-list":
+		h = mp.statsList
+	case "baseline":
+		h = mp.baseline
+	default:
+		if mp.isResource(r) {
+			http.StripPrefix(mp.Path, fsHandler).ServeHTTP(w, r)
+		} else {
+			fsHandler.ServeHTTP(w, r)
+		}
+		return
+	}
+	if !mp.authorized(r) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	h(w, r)
+}
+
+// authorized returns true if the client that made r may see profiles: the
+// popup of a profiled request and the results, stats and baseline pages and
+// endpoints.
+func (mp *Profiler) authorized(r *http.Request) bool {
+	return mp.Authorize != nil && mp.Authorize(r)
+}
+
+// isResource returns true if r is a request for one of mp's resources.
+func (mp *Profiler) isResource(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, mp.Path)
+}
+
+// urlPath returns the path of the profiler's resources as seen by the client
+// that made r.
+func (mp *Profiler) urlPath(r *http.Request) string {
+	if !mp.ForwardedPrefix {
+		return mp.Path
+	}
+	prefix := strings.TrimSuffix(r.Header.Get("X-Forwarded-Prefix"), "/")
+	if !validPrefix(prefix) {
+		return mp.Path
+	}
+	return prefix + mp.Path
+}
+
+// validPrefix returns true if prefix is safe to prepend to a URL path: it
+// must be absolute, but not protocol-relative, and contain only unreserved
+// characters.
+func validPrefix(prefix string) bool {
+	if !strings.HasPrefix(prefix, "/") || strings.HasPrefix(prefix, "//") {
+		return false
+	}
+	for _, c := range prefix {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case strings.ContainsRune("/-._~", c):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// NewHandler returns a new profiled handler.
+func (mp *Profiler) NewHandler(f func(Timer, http.ResponseWriter, *http.Request)) Handler {
+	return Handler{
+		f:        f,
+		profiler: mp,
+	}
+}
+
+// update calls f with the stored profile id, with the profile locked, and
+// stores it again if f returns true. It returns the profile, or nil if it was
+// not found. Updates to a profile are serialized, so one does not overwrite
+// another made by a concurrent request with its own copy from Get. Updates
+// made by other processes sharing the store may still be lost.
+func (mp *Profiler) update(r *http.Request, id string, f func(*Profile) bool) *Profile {
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	l := &mp.updates[h.Sum32()%uint32(len(mp.updates))]
+	l.Lock()
+	defer l.Unlock()
+	p := mp.Get(r, id)
+	if p == nil {
+		return nil
+	}
+	if p.profiler == nil {
+		p.profiler = mp
+	}
+	p.mu.Lock()
+	changed := f(p)
+	p.mu.Unlock()
+	if changed {
+		mp.Store(r, p)
+	}
+	return p
+}
@@ -0,0 +1,328 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofiler
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+)
+
+// maxIncludesBuffer is the largest HTML response buffered for AutoIncludes.
+// Larger responses are streamed unmodified.
+const maxIncludesBuffer = 4 << 20
+
+// responseWriter records the response of a profiled request. If inject is
+// set, text/html responses are buffered until finish, which adds the
+// profile's Includes before </body>. If timing is set, a Server-Timing header
+// is added when the header is written.
+type responseWriter struct {
+	http.ResponseWriter
+	p      *Profile
+	inject bool
+	timing bool
+
+	status int
+	size   int64
+	header http.Header
+	sent   bool
+	buf    *bytes.Buffer
+}
+
+// omitHeaders are not recorded in Profile.ResponseHeaders.
+var omitHeaders = []string{
+	"Set-Cookie",
+	"X-Miniprofiler-Ids",
+}
+
+func (w *responseWriter) WriteHeader(code int) {
+	if code >= 100 && code < 200 && code != http.StatusSwitchingProtocols {
+		w.ResponseWriter.WriteHeader(code)
+		return
+	}
+	if w.status != 0 {
+		if w.sent {
+			w.ResponseWriter.WriteHeader(code)
+		}
+		return
+	}
+	w.status = code
+	if !w.canInject() {
+		w.sendHeader()
+	}
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if w.size == 0 && w.buf == nil && len(b) > 0 {
+		w.sniff(b)
+	}
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if !w.sent && w.buf == nil {
+		if isHTML(w.Header().Get("Content-Type")) {
+			w.buf = new(bytes.Buffer)
+		} else {
+			w.sendHeader()
+		}
+	}
+	if w.buf != nil {
+		if w.buf.Len()+len(b) <= maxIncludesBuffer {
+			return w.buf.Write(b)
+		}
+		w.bail()
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.size += int64(n)
+	return n, err
+}
+
+// sniff sets the Content-Type of a response without one from b, its first
+// bytes, as net/http does. If the header has already been sent, net/http
+// sniffs b itself, so only the recorded header is updated.
+func (w *responseWriter) sniff(b []byte) {
+	h := w.Header()
+	if w.sent {
+		h = w.header
+	}
+	if h != nil && h.Get("Content-Type") == "" && h.Get("Content-Encoding") == "" {
+		h.Set("Content-Type", http.DetectContentType(b))
+	}
+}
+
+func (w *responseWriter) Flush() {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	// Flushing means the handler is streaming, so give up on injection.
+	w.bail()
+	w.ResponseWriter.(http.Flusher).Flush()
+}
+
+// canInject reports whether the response may be buffered for injection,
+// before its body has been seen.
+func (w *responseWriter) canInject() bool {
+	if !w.inject || w.p.r.Method == "HEAD" {
+		return false
+	}
+	if w.status < 200 || w.status == http.StatusNoContent || w.status == http.StatusNotModified {
+		return false
+	}
+	h := w.Header()
+	switch h.Get("Content-Encoding") {
+	case "", "identity", "gzip":
+	default:
+		return false
+	}
+	ct := h.Get("Content-Type")
+	return ct == "" || isHTML(ct)
+}
+
+func isHTML(contentType string) bool {
+	t, _, err := mime.ParseMediaType(contentType)
+	return err == nil && t == "text/html"
+}
+
+// sendHeader writes the recorded status code to the underlying writer.
+func (w *responseWriter) sendHeader() {
+	if w.sent {
+		return
+	}
+	w.sent = true
+	if w.timing {
+		w.Header().Set("Server-Timing", w.p.ServerTiming())
+	}
+	w.header = cloneHeader(w.Header())
+	w.ResponseWriter.WriteHeader(w.status)
+}
+
+// bail stops buffering and writes out anything buffered so far.
+func (w *responseWriter) bail() {
+	if w.sent {
+		return
+	}
+	w.sendHeader()
+	if w.buf != nil {
+		b := w.buf.Bytes()
+		w.buf = nil
+		n, _ := w.ResponseWriter.Write(b)
+		w.size += int64(n)
+	}
+}
+
+// finish completes the response at Finalize. A handler that wrote nothing is
+// sent 200 OK, as net/http does once it returns, and a buffered response is
+// written out with the includes injected into it.
+func (w *responseWriter) finish() {
+	if w.sent {
+		return
+	}
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.buf == nil {
+		w.sendHeader()
+		return
+	}
+	body := w.buf.Bytes()
+	w.buf = nil
+	h := w.Header()
+	if b, err := injectIncludes(body, h.Get("Content-Encoding"), w.p.Includes()); err == nil {
+		body = b
+		h.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+	w.sendHeader()
+	n, _ := w.ResponseWriter.Write(body)
+	w.size += int64(n)
+}
+
+// injectIncludes inserts includes before the last </body> of the HTML in
+// body, which is compressed according to encoding.
+func injectIncludes(body []byte, encoding string, includes template.HTML) ([]byte, error) {
+	html := body
+	if encoding == "gzip" {
+		r, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		html, err = ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+	i := bytes.LastIndex(bytes.ToLower(html), []byte("</body>"))
+	if i < 0 {
+		return nil, fmt.Errorf("miniprofiler: no </body> in response")
+	}
+	var b bytes.Buffer
+	if encoding == "gzip" {
+		gw := gzip.NewWriter(&b)
+		gw.Write(html[:i])
+		gw.Write([]byte(includes))
+		gw.Write(html[i:])
+		if err := gw.Close(); err != nil {
+			return nil, err
+		}
+	} else {
+		b.Write(html[:i])
+		b.WriteString(string(includes))
+		b.Write(html[i:])
+	}
+	return b.Bytes(), nil
+}
+
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.sent = true
+	w.buf = nil
+	return w.ResponseWriter.(http.Hijacker).Hijack()
+}
+
+func (w *responseWriter) Push(target string, opts *http.PushOptions) error {
+	return w.ResponseWriter.(http.Pusher).Push(target, opts)
+}
+
+// Unwrap returns the underlying http.ResponseWriter, for use by
+// http.ResponseController.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func cloneHeader(h http.Header) http.Header {
+	c := make(http.Header, len(h))
+	for k, v := range h {
+		c[k] = append([]string(nil), v...)
+	}
+	for _, k := range omitHeaders {
+		delete(c, k)
+	}
+	return c
+}
+
+// unwrapper is implemented by http.ResponseWriter wrappers that support
+// http.ResponseController.
+type unwrapper interface {
+	Unwrap() http.ResponseWriter
+}
+
+// wrap returns w as an http.ResponseWriter that implements exactly those of
+// http.Flusher, http.Hijacker and http.Pusher that the underlying writer
+// does, so type assertions by handlers behave as if it were not wrapped.
+func (w *responseWriter) wrap() http.ResponseWriter {
+	_, f := w.ResponseWriter.(http.Flusher)
+	_, h := w.ResponseWriter.(http.Hijacker)
+	_, p := w.ResponseWriter.(http.Pusher)
+	switch {
+	case f && h && p:
+		return struct {
+			http.ResponseWriter
+			unwrapper
+			http.Flusher
+			http.Hijacker
+			http.Pusher
+		}{w, w, w, w, w}
+	case f && h:
+		return struct {
+			http.ResponseWriter
+			unwrapper
+			http.Flusher
+			http.Hijacker
+		}{w, w, w, w}
+	case f && p:
+		return struct {
+			http.ResponseWriter
+			unwrapper
+			http.Flusher
+			http.Pusher
+		}{w, w, w, w}
+	case h && p:
+		return struct {
+			http.ResponseWriter
+			unwrapper
+			http.Hijacker
+			http.Pusher
+		}{w, w, w, w}
+	case f:
+		return struct {
+			http.ResponseWriter
+			unwrapper
+			http.Flusher
+		}{w, w, w}
+	case h:
+		return struct {
+			http.ResponseWriter
+			unwrapper
+			http.Hijacker
+		}{w, w, w}
+	case p:
+		return struct {
+			http.ResponseWriter
+			unwrapper
+			http.Pusher
+		}{w, w, w}
+	}
+	return struct {
+		http.ResponseWriter
+		unwrapper
+	}{w, w}
+}
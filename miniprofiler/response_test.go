@@ -0,0 +1,221 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofiler
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const testPage = "<html><body>hello</body></html>"
+
+func gzipped(s string) string {
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	w.Write([]byte(s))
+	w.Close()
+	return b.String()
+}
+
+func TestResponseWriter(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		auto   bool
+		h      func(w http.ResponseWriter, r *http.Request)
+		// inject is whether the includes are expected in the response.
+		inject      bool
+		status      int
+		contentType string
+	}{
+		{
+			name: "html",
+			auto: true,
+			h: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(testPage))
+			},
+			inject:      true,
+			status:      200,
+			contentType: "text/html; charset=utf-8",
+		},
+		{
+			name: "html without AutoIncludes",
+			h: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(testPage))
+			},
+			status:      200,
+			contentType: "text/html; charset=utf-8",
+		},
+		{
+			name: "html with status and content length",
+			auto: true,
+			h: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/html")
+				w.Header().Set("Content-Length", strconv.Itoa(len(testPage)))
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(testPage))
+			},
+			inject:      true,
+			status:      404,
+			contentType: "text/html",
+		},
+		{
+			name: "gzip",
+			auto: true,
+			h: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/html")
+				w.Header().Set("Content-Encoding", "gzip")
+				w.Write([]byte(gzipped(testPage)))
+			},
+			inject:      true,
+			status:      200,
+			contentType: "text/html",
+		},
+		{
+			name: "unknown encoding",
+			auto: true,
+			h: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/html")
+				w.Header().Set("Content-Encoding", "br")
+				w.Write([]byte(testPage))
+			},
+			status:      200,
+			contentType: "text/html",
+		},
+		{
+			name: "json",
+			auto: true,
+			h: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"body": "</body>"}`))
+			},
+			status:      200,
+			contentType: "application/json",
+		},
+		{
+			name: "no body tag",
+			auto: true,
+			h: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("<html>hello</html>"))
+			},
+			status:      200,
+			contentType: "text/html; charset=utf-8",
+		},
+		{
+			name: "flush",
+			auto: true,
+			h: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("<html><body>"))
+				w.(http.Flusher).Flush()
+				w.Write([]byte("hello</body></html>"))
+			},
+			status:      200,
+			contentType: "text/html; charset=utf-8",
+		},
+		{
+			name: "too large",
+			auto: true,
+			h: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("<html><body>"))
+				w.Write(bytes.Repeat([]byte("a"), maxIncludesBuffer))
+				w.Write([]byte("</body></html>"))
+			},
+			status:      200,
+			contentType: "text/html; charset=utf-8",
+		},
+		{
+			name:   "head",
+			method: "HEAD",
+			auto:   true,
+			h: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(testPage))
+			},
+			status:      200,
+			contentType: "text/html; charset=utf-8",
+		},
+		{
+			name:   "nothing written",
+			auto:   true,
+			h:      func(w http.ResponseWriter, r *http.Request) {},
+			status: 200,
+		},
+		{
+			name: "no content",
+			auto: true,
+			h: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			},
+			status: 204,
+		},
+	}
+	for _, test := range tests {
+		mp := New()
+		mp.AutoIncludes = test.auto
+		var p *Profile
+		mp.Store = func(r *http.Request, prof *Profile) { p = prof }
+		h := mp.NewContextHandler(http.HandlerFunc(test.h))
+		method := test.method
+		if method == "" {
+			method = "GET"
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(method, "/", nil))
+		if p == nil {
+			t.Errorf("%s: not profiled", test.name)
+			continue
+		}
+
+		body := w.Body.String()
+		if w.Header().Get("Content-Encoding") == "gzip" {
+			r, err := gzip.NewReader(strings.NewReader(body))
+			if err != nil {
+				t.Errorf("%s: %v", test.name, err)
+				continue
+			}
+			b, _ := ioutil.ReadAll(r)
+			body = string(b)
+		}
+		injected := strings.Contains(body, `id="mini-profiler"`)
+		if injected != test.inject {
+			t.Errorf("%s: injected = %v, want %v", test.name, injected, test.inject)
+		}
+		if injected && !strings.HasSuffix(body, "</body></html>") {
+			t.Errorf("%s: includes not before </body>: %q", test.name, body)
+		}
+		if cl := w.Header().Get("Content-Length"); cl != "" && cl != strconv.Itoa(w.Body.Len()) {
+			t.Errorf("%s: Content-Length = %s, want %d", test.name, cl, w.Body.Len())
+		}
+		if w.Code != test.status {
+			t.Errorf("%s: status = %d, want %d", test.name, w.Code, test.status)
+		}
+		if p.StatusCode != test.status {
+			t.Errorf("%s: StatusCode = %d, want %d", test.name, p.StatusCode, test.status)
+		}
+		if p.ResponseSize != int64(w.Body.Len()) {
+			t.Errorf("%s: ResponseSize = %d, want %d", test.name, p.ResponseSize, w.Body.Len())
+		}
+		if p.ContentType != test.contentType {
+			t.Errorf("%s: ContentType = %q, want %q", test.name, p.ContentType, test.contentType)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+//go:build go1.23
+// +build go1.23
+
+package miniprofiler
+
+import (
+	"net/http"
+)
+
+// ServeMuxRouteName returns the http.ServeMux pattern that matched r, such as
+// "GET /users/{id}". It is suitable for Profiler.RouteName when the ServeMux
+// is wrapped by NewContextHandler.
+func ServeMuxRouteName(r *http.Request) string {
+	return r.Pattern
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofiler
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ServerTiming returns the value of a W3C Server-Timing header summarizing p
+// so far: the total duration, each top-level step, and the total duration and
+// count of each custom timing call type. It is empty if p is not enabled.
+func (p *Profile) ServerTiming() string {
+	if p.Root == nil {
+		return ""
+	}
+	metrics := []string{
+		serverTimingMetric("total", p.Name, Since(p.start)),
+	}
+
+	p.Root.Lock()
+	steps := p.Root.Children
+	p.Root.Unlock()
+	for i, t := range steps {
+		metrics = append(metrics, serverTimingMetric("step"+strconv.Itoa(i+1), t.Name, t.DurationMilliseconds))
+	}
+
+	durations := make(map[string]float64)
+	counts := make(map[string]int)
+	p.Walk(func(t *Timing) {
+		for callType, cts := range t.CustomTimings {
+			for _, ct := range cts {
+				durations[callType] += ct.DurationMilliseconds
+			}
+			counts[callType] += len(cts)
+		}
+	})
+	var callTypes []string
+	for callType := range counts {
+		callTypes = append(callTypes, callType)
+	}
+	sort.Strings(callTypes)
+	for _, callType := range callTypes {
+		desc := fmt.Sprintf("%s (%d)", callType, counts[callType])
+		metrics = append(metrics, serverTimingMetric(callType, desc, durations[callType]))
+	}
+
+	return strings.Join(metrics, ", ")
+}
+
+// serverTimingMetric formats a single Server-Timing metric. name is reduced
+// to a valid token and desc is quoted.
+func serverTimingMetric(name, desc string, ms float64) string {
+	name = strings.Map(func(r rune) rune {
+		if r < 0x80 && (r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("!#$%&'*+-.^_`|~", r)) {
+			return r
+		}
+		return '_'
+	}, name)
+	s := fmt.Sprintf("%s;dur=%.1f", name, ms)
+	if desc != "" {
+		desc = strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(desc)
+		s += `;desc="` + desc + `"`
+	}
+	return s
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofiler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServerTimingHeader(t *testing.T) {
+	mp := New()
+	mp.ServerTiming = true
+	mp.Authorize = func(r *http.Request) bool {
+		return r.Header.Get("X-Developer") != ""
+	}
+	h := mp.NewHandler(func(t Timer, w http.ResponseWriter, r *http.Request) {
+		t.Step("render", func(Timer) {})
+		w.Write([]byte("ok"))
+	})
+	for _, developer := range []bool{false, true} {
+		r := httptest.NewRequest("GET", "/", nil)
+		if developer {
+			r.Header.Set("X-Developer", "1")
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		st := w.Header().Get("Server-Timing")
+		if developer && !strings.Contains(st, `desc="render"`) {
+			t.Errorf("developer: Server-Timing = %q, want the render step", st)
+		}
+		if !developer && st != "" {
+			t.Errorf("unauthorized: Server-Timing = %q, want none", st)
+		}
+	}
+}
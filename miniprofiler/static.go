@@ -201,1071 +201,1106 @@ EIC8rLX9pCJ//FEXdToe8oOe/v0EAAD//4yIcAP2AQAA
 
 	"/includes.css": {
 		local:   "../ui/includes.css",
-		size:    13910,
+		size:    14439,
 		modtime: 1456280857,
 		compressed: `
-H4sIAAAJbogA/7Qb627bvPX3/BQaig/YiqhwEjt27T8rBhR7DUqibCKyqFG0nTTIu483SRQvImVnKYZ9
-knjuh+dG+kdDcIkqSFIC23NFHxbDm/+eIUGwTT4WSZLjCpNd8m29Xu/ZY4VqmB4hOhzpLnnkb0pc07RF
-fyB7fmre9ovPhYk7aQh04Tdeq8U5LpyrzfdqeQUyWLnWWx8UAAVZ5SRgfegAMly8OwHMDx3AEYLCCWB+
-6ABKjJ0WsD50AMS5mjiZcXPiWupmuhCOcALkgOpdsuRGb0BRoPqgnjJMCkjUg+4Py+Vf3atdguojQ0j5
-iwskFOWgSkGFDgxnBlrIPUsgA/nrgeBzXaTK9ygBddsAAmsBjBl0WeHrLrmgFjGT7QV3b71b1phjcrjh
-tOWFlFIUTrkCTctk6P5rkDNlvOSd8A4qwEUBONQNdkcui3O5/CR34Jm0XA0NRjWFZK9tyuVys8nzGVwI
-hBS+0bSAOSaAIlxPKCySQwshMx4k0qAOrB+dl5TghKr3XfIfWF0gd4iH5BdBoHpIWmbxtGWUSr8hh7d5
-hZhvpBSdmFlazVtTihvuhp6wNLwQscKMdyrkDf52REUB630n8fABVhVqWtQGqJxrREdEgPgLQFGCLkwp
-ArBAbVOB9wmT2XDUFXFcy5LvDuaSv6NTgwkFfPOFQ7snhltU6/Mpg65w5dDWyFf+jesWV6B9SE64xnwr
-QuYuXk8xKY4kfBR/AThUl3jwchWwCI80MYAafXCSIYa5DGBRqoLlTAxMygs3GFKIrkdEoYhGkDvElYAm
-5BJqh9CjDHZWqP1WluXe4aDzpR9ITXtft6y4UyRNTUd8TU+YwPk7xsJVnGVIm+eHtofdrj/dG1jwkTui
-gpTqiWgVDHA2OhXIhbrmbcxoXFa8m8hXMehH1lAlSMr30S55CWogP7cUnxR2EbsvCF4Fqm5PKrvwF6ra
-4anDk+aDmKdcer4/eInsmBPSND+iqlDCjDWzDSc/VL/6siazXQUB2WWYHqPQgGGryCLw51rUgP0+RLXo
-IbIK56/7gaZk1ttBWGR2JSKG3CNM4ZxP8eHAZGOVRK8B3Q0Ywml4vUu6r5yxcWrRjLIgzZI0cx/dRMwe
-zB2YwtZBObVua24YG/kug08jAvLQYQRyjKqme0nYTmOsoKLvNQWjV1XUZ7gqRp2HlPyWlGE0NhZGfWvK
-LO0XI5KQuUm7LUqkcE/xVhze4KLoRHBwB9f833x3Q3UOudMRV9s5BTkKznOjm41O1D4FuvjQWTuhcwfm
-VWlGIHhlPsP/j8FVXYd4RQU97pLnZTg62BwdQCO4mqmXDmzCVnkwJ06i/cG/vstk45b+VtwTqXyz2XwF
-3puraz92q7XhX9rZxaBNQEPHTFdTwPIYsZjPoezS45C3dNySbJf8Lxb69VoY9dVyuc1ioXN8Mmjzf7HQ
-9L0ZQT9lPx9BdH6rDHeaJ3dzri25Z0BX90BTcLiDc0DHtMtyHvTF4jw6C/0oYG5xHrT3FZCaZarJoKfW
-JN+jVrnbhjtAp8JUGVSuwm1Puvxizljr5vhuBIbI+rQwWvD6LEp7G8pZecWgimdDhuM/oqd9Y/XP42qz
-2j6/rJ5FiYdbJOeIJXqDouoTHYknabdab8CqtYdkYoEsFvgy1UdPtAoGXk1aLMI/wVVr0dILPY3smZGt
-bXsbJPR2ryPA2WSmyF4RTUcVs6wdUwIKdG6Hdi094T/dQvlNrVelZrcujCuokS8RcBJGM9csWk64kUo7
-DSmlDL3HVvwFpBdgEY4m1iWCoKIU1qxY+H92NsnXLG/jyox3NtXNO33NgSmskK+RL8JpbqDmBnS5m1LL
-TG9zerMVg+/dFjeIeDsPE8r6mzEXYPmjeyXnUjdrL3rDfnQZRzuy7NkJ+uuEuUZDK1Ntrs3HuIiP82yx
-O8j7sESI4Tb5TXLERhC21hk+PDg+F47qIsIlfVvV6BFdVcpyenzVueXU8AvU6NSdvsJrynpG1sGvW3ml
-op9IrRjSjT2U6kcg1tGwq9IydWE+pyCn6OI4rnOpyAPsmWycAMG4voutiKOJOXzOwxbPmah/v441R2Uu
-/SaqMr9FBN5GfBX/dhfmYL7G5AScs6nw1uwPD6zLR/NO6dT2KWAJGF1zm/knOU4OGfZ6urYcTlON+a+a
-XaqJ5vjlzD0+YsccO4858w3BdHQNbs5NbFx8HHdvIGNyn6nnVs/4GMDU0ygMMk30ycA1nB9fStvKhcPt
-DHCmWMs1b6yDBgX/wA/3OFk++NdvfAzJK7w2vCZGwcNzPxA1xtvPUqrOcbrXT44Ke6TLogiGCCcLWjTq
-Ly5om+1xuNk1KxzF0BoeuQ1BVY3PFoJD3Bn0zCsV5rnlPORTdx6i4IwDqaXvWDuOCc9JrOlCz7fT0Lr7
-/gKVds7ytPGcazkO92NjzHM/DnpWEUEWi92Tim3do2OW1AWG9H0IDf27t+Fd/JGfSxxb/Y+bYM3NtGq3
-V3E+70Nip6cvwDVakDKLe7OL7s16knM0Ut18Mzt4hoNPe5eLDGaSA3FhTn4xhrJFyx8bf04ahoqjSSBj
-kQmlXFheYbV1lJbnqnLbSkH+XBsCc5dUzhWNz9lbBQ0YROZNOpq7Pq1DqcUuZ4xkZaQw3yn3bewGsoR5
-wr7UqkMp4FamsP40xD1JmM3cEMztJHA7sqPjztN2XBIoST33fb6KdGdMTzC7Q11WvFy5Sx7PZbc7KFt3
-icZ69g1LbqYYSJvr9a0E9VzahR2+69xX2KLRDdfjb4uKUdnxRbU7E783ebpXL64bPxOdxR2UjGA1+yLL
-58K4465Ss0yKSfKh/ejC/F3Cch+AFr9bSSjZ1fQovf4fuCj+yZGaNc83COE+mY8PXmDtRshrqCBCknwM
-8oUW8x/VSBC7YsuybN/71Gq12lsjg1js3GWNlnQdAc48rgfaivWepWkBKNtkoyaLre+Zf3l5mQCWHYzm
-Xmp48blY/OsVvpeENVatNmrkzrj8S12cdOjt9+/fv3794t75yf7H9/vkWrnwc/G/AAAA//9MOPbTVjYA
-AA==
+H4sIAAAAAAAC/7Ub7W6jOvb39ilYjUbaexUq2iZNJvlzR1ca7WsYMIlVglnjJO1Uefc9xiYYbLAhvRmN
+ZgI+38fny85jyWhGcsxChqtTzhcPj7cn/zthRnAVfD4EQUJzyrbBt9VqtYOvOSlweMBkf+Db4Ek8yWjB
+w4r8xvD9uXzfPVw1TBJ3UDJsw997rBYnNLWu7j9Xy3MU49y23nihADiKcysB40UDENP0wwrQf9EAHDBK
+rQD9Fw1ARqnVAsaLBoBZVzMrM3ZObEvtTKe1IxwR25NiG0TC6CVKU1Ls1beYshQz9UX3hyj63jzaBqQ4
+AEIuHpwx4yRBeYhysgecMaqw8KwaGUre9oyeijRUvscZKqoSMVzUwBSgs5xetsGZVARMtqu5e7+5ZUEF
+putUy9dSSlEE5RyVFcjQ/K+VMwRekkZ4CxVko4As6kbbg5DFuly+kjvwxCqhhpKSgmO20zZlFK3XSTKB
+ixohx+88THFCGeKEFiMK8+TQQAjGw0wa1IL1s/GSDB1J/rEN/ovzMxYOsQh+MoLyRVCBxcMKKGXDhmyf
+JjkB3wg5OYJZKs1bQ05L4YYDYal9UMeKfrxTIa/1twNJU1zsGonbFzjPSVmRykHlVBDeIYLqjwOKM3IG
+pdSAKanKHH2MmMyE47aIY1sW/GlhLvg3OZaUcSQ2nzu0D8Rwg2pxOsaYLby01fGVv2lR0RxVi+BICyq2
+IgZ3GfSUPsWOhE/1xwFHioy2Xq4CFhORxgdQo4+OMsSAyyCIUjnOJmIAKc/CYEQhuhwIx3U0wsIhLgyV
+LpdQO4QfZLAzQu23LMt2FgedLn1LauG1LL1TJE1NB3oJj5Th6TvGwJWeZEib5oemh83Xn+4NEHzkjsgx
+53oiWjoDnIlOBfJaXdM2pjcuI96N5Csf9B1rqBIkFPtoG7w6NZCcKk6PCnsdu88EX2pUzZ5UdhEPVLUj
+UsdAmndiHnPp6f4wSGQLTsjD5EDyVAnT1czG7RuokzRlwnRKXUMpGRuKTBZgNUmQHMqlMGYYvUGFJ/4B
+eXOXmDKVF+hM9q2t9Wy+lMi1MvPH6rsfVnhOTyzBlTXzixLyQlJ+2AYvz5GkMin3zwlfDO/hWQWCzjCB
+DjxqCd3ZPPIOVG5vQ2UUbOYcI7aNKT94oUFt7NTMpQVmUtRNZZzT5G3X0pTeO9hSGmS2GWG9jdDB5C4C
+Od3vQTYoLW8a0OMCIByH19vm++pbE6eW3jhkbajawMd0E4E9ID6AwlZOObX2e2pe6zg4wIceLt62nI6i
+Q7VXN0lgBwArJL0NH2pGL6rLi2medlpRKfmcTdjrdA2M5vYZFsOTUD9q9/bts78V2yc0TRsRLNzhlfgz
+3d1IkWDhdIwvpkF2svXUdGeiq4vhlJyH0Bk7YTWahbrxPopm6HuPypqrxTywEVslziJpFO2jePshq48Z
+OXgE90htt16vvwLv7HZrGLvR64o31eTuwCSgoQPTFRxBHmMG8wmWYxs/5BXv9qibSHx8od8uaa/gjqJN
+7Aud0GOPtvjjC80/yg70c/zjCXnnt7znTtPkLk+FIfcE6PweaCiE7+Ac8S7tLJsGfTY4985CjylODM6d
+9r4gVkCmWnisCf70WmXvI+8AHQtTWeQpoDn6HBZzwlo7x3cj6Imsj4+9BS9OdWlvQlkrLx9U/mzIcPy7
+HnK8Q/3ztFwvNy+vy5e6xKMVkYPljLzjuuqrO5KBpF1pvQFUa4tgZIEsFsQyNVgZaRV6eDVpaR3+Gc0r
+g5Ze6GlkT0C2WLhI6C1sQ0CwCaaI3wgPOxWzrB1DhlJyqtp2LTzS381C+U6tV6Vms86N6zqB3fkCjsJo
+5ppEywrXUWmjIaWUtvfY1B+H9DWYh6PV64KaoKLk1my98B92NsnXJG8TyvR3NtXNW33Nguk6hdk75PNw
+mhnU7IA2d1NqmehtVm82YvC922KGiPN5GFHWv3pzAcgfzSM5l5qtPe8N+9lkHO0M+8aO019HzNUZWvXV
+Ztt8wIV/nIfF9iA/hOU61+Sz5PCNILDWGj4GcFwfLNWFh0sObdVej2irUqLx8VXjlmPDL1SQY3Mcjy8h
+9IzQwa8qecfmNpFaAtK1OZS6jUCMuwJXD130v4co4eSM/VQ0ADww2TgiRmlxF1seZ1VT+JyGzZ+zuv79
+OtYslbn0G6/KfI4Ioo34Kv7NLszCfEHZEeUu9u1b83Z4YNxGm3Zsq7ZPijMEdHfekxwrh4C9GK8t2+P1
+3vxXzS7VRLP7cOIe77DTHzt3ORsagunoSlqeSt+4+NTt3lAMcp843nkcA/T11AmDoIlbMrAN57u3FDf9
+szx04lTLNe/QQaNUvBCHboKsGPzrV4Da5OVe617jo2DLQLQ33n6RUjWO0zx+tlTYHV2maTqLBdtNFm2z
+PbVX/SaFIx9a2hEH2BDlefdswTnEnUCvf8fGfcw8hnzsEowXXO9AKhq65+DHxMBJbN+FXubTcJyrP69X
+btz6MapHjHm5jYNeVESQxWLzTcW25qtlltQEhvCjDQ23Z+/tM/8jv2r4+FPfM2tnzQ1aDaz3J90+P4TE
+TE9fgKuzIASLD2YX3Zv1JGdppJr5ZrwfGA4+72wu0ppJDsRrc4qbUhwWRY/r4ZzUDhU7k0BgEYRSLizv
+NJs6CrNTntttpSB/rHoCC5dUzuWNz9pbOQ3oRDaYdDR3fV65UotZzvSSVS+FDZ1yz2PXkSX6J+yRcY9o
+I1PY7TTEPkmYzFwbzBdfiOxguQS36ZYE+h2g6z9GujHm+qvJpEa8XNpLnuWXUzbuEnX1PDQsmU3RkTZX
+q7kE9VzahB2x6+xXy7zRtb+XmBcVvbLjq2p3Rn6A9HyvXmw3fkY6izso3XuR5frQ+9GDSs0yKQbBp/Yr
+nP4PVaKdA7r+IVPA2bbgB+n1/6Fp+odA2q95vmGMd8F0fPiMCztCUUM5EbLgs5XPtVj8ykqCmBVbHMe7
+m08tl8udMTLwxS5ctteSrjzAweNuQJt6/cDSMEUcNlmnyYL1N+ZfX19HgGUHo7mXGl5cHx7+esMfGYPG
+qtJGjcIZo+/q4qRFb79+/fr586fwziv8Fft9dK1ceH34P0aud5JnOAAA
 `,
 	},
 
 	"/includes.js": {
 		local:   "../ui/includes.js",
-		size:    177543,
+		size:    185236,
 		modtime: 1456280857,
 		compressed: `
-H4sIAAAJbogA/+y9+5/aRrIo/vPJXyEUn0EyggE7j42whuv4sfGexPGxvZu9lyH+aKAB2UIikphHBvZv
-/1ZVP9SSWsw4m3vuL1924wGpH9XV1fXq6mpnuUvmRZQmzlWULNIr9/YLe5czKy+yaF7Y4y++OD21MraN
-wzmzijWzFmwZJczasGKdLqxllm7g9W+7KGMfc8+KllgoY1aUW2FyM8DaxRp+wP8TNmd5HmY3VpFa4WUa
-LaxNuoiWN1GyoqY3URItI7awdsmCZfk8hXbCZGF9/G3HoFae7rI5oyajogvtpYW1TfM8uogZNtldsJgV
-rEttbbN0y7JCQlCHG8DJWF5ADwvLAaCvwtxi11FeACyuFS4LllEtMyRxdJHhOLBWxlZQDYa8+OIyzKw0
-i1ZREsbPeXeBxdE64N2Pv4C+nGoZt1oEqsgpgRFuHde6tQ4wDdj4T4CgN1m6jGIAL7DU3FlY6gsLPtW5
-wydY74FHX/HzQX2DNuX7dIut5GWpeZoUIQCTVR9laawVKjbb+Fk4XyPIt4fy+ZIV8HDxapHDi+ms9gIQ
-rN5YMDd5al0xoI1LGNJ2F8cWoCKxQpxAPs7iKpoz1Ub4Mbx+V4RZ8T7aMNNY1mH+YzoP43cwueGqgk6F
-JYIe5q/8hZ+MFbsssbqxVr1rRYmYH+vkRHybVovMrE4QWAnAPlbtHax5CIO1HOaae1mGcc608vTtoI1j
-xYp/AOkB3GzxX+ymMo5P7OZNBtRyrTcuGlbvrJ7V7XfhXzG7g0ve3LjRVR5eMnP7HryPd5UxIAF3akh2
-XKRSDsAYxqIKw/zOY4YUFi8QshwwWKw5R9iKHqBB4BQlkaSZ5SBUEYA0HMOfJ5aO7kHMklWxhhe9Xh23
-CJtTKQx9OpFr7fdWt+sOYPbY9c9LHX9nVn9UbwY/lVYytkkv2auCbUytu+NK7YM2qToiCMvET/j4xXR8
-YepxWpt9DeIZoIUmpTmPcRou7qYTAAVxTN0BV7z0rDCOgbxZmN9YFzfbEDgqMOR0CSQM6/iOiUfUCv6F
-LWK/gzX0qXDd3WyTlFoKugrdklhp0eh4Es/vi4smDojHvGfAmsKC5RVs5Ls5CiAdF1jjEy2ubiHrdL3K
-dBLoiFbELvbtjr+o4IQXqNPQA6d7kS5ugOjC7ZYlC1msSisCJMetcwL8MOAQ9VYHgAxHLuhtCGsJVnmU
-zOPdguUD5MiTy8Cw6D0ND4uwCE0kj4Npe0fAAgEjAjyLSo2NhRrjPlLWMPomFuhXAz81TvksjlhSvGEZ
-UPYmTOY6O3MMTFJQ7FavwFm4NeF/fEOZFmp7y/JdXFRpLVo06Gxeh9GTj7L0gqHu5FkfPWsLXxb437VG
-Z8QUNYYIzR/lg0ivUGYazbRG8NMAwqpLLq0UwaUKNDitQNDmTWGimFobUArYKCHKNN80wI98gB9hgHpt
-NdKPzZGaept+nA0QAY1nuHhQbbib4PCDEC/jXb6uFz80UYEID9Sam++yDHp+RUyhDTGVKTCRsOOOm5WJ
-4TQa6PA5akMOKsykkV/sVqsbUg+3VrorFL3mxmpEtOkWueOtlYSX0YrYu4/KnlVEoK2v8LtaEKb6vBi0
-8GDArgvkB1i5MYIBL2caMX6IOraoiolyLQOVGOKlBiCsfr5K3ghLwNm6qMJ1Hgyi/KXkDbzkdDtzj7WJ
-H8TEQBWHEanvZuaGnyZRtT81zuygRPsx+Ai2sigstAXYY/PiWbpLinIlGNutFjaPxQyxiZARkpZJBCpc
-h/NPZPwtFtZ8DaYjE1g0E6DGZERppYKLBwMUyrik83b0EBlTaSpYmmT1JkxsoQREa2KwjLK8eAMGErGT
-ozPTQulT+yW2YVEjFrZiI039BBJ9kMFELI70Zz20RsPhsBVa82z93x/FU7Kaf0Ql9P4Doko/iim4e2T3
-JM+a+sBVKRx/xXyUfBPJqiKuar+FAALldXhE0lXWwW3JIDXWST37VRu2FEoWsdJ661WITdLaII+AxyVP
-sywE8wR0idIgd0G0DnF4hgLcMKcSRu1wcY0aTllysEXZGIGQ61sjw5p/MEDQHTNV7bLYt+p6rNAPagq4
-/KAiieiMFj5pSA20+3epWH5N4dqm293Wt0bWob3H9zdbaLj7MU+TFrgKXuLNz+/et5RA1wl0y5uyQS+O
-I24pnV73r66u+ghtHzDCknm6YIsxsKswy1kR/P39y/5fbHOj2yxF5fk5YaXIdjBWw+eY3u1r+iqO7xgH
-KElITXu72EMKxAZxfdnraLFgiX2XeL3YFQUskHV6xWH5bKHahnowiVjB/BYvkHGcgr5znCcGQ732rFEL
-QAbWc8QdQP9q5oOYw78hroIjs4G/Bz+E+fMdJx32bJcX6eY9l51YtepNkuXvWex9Fl1GYXy8nN4W8COy
-d24PxkI/RsknfN14tN83q4jOf4riOMoZLBVyDkrWUDTf1uq/TdNi8CZEhZsD92ohu361KMvq7o9FtFwy
-rGDlLIPWo9/BSAblJFnEDBc9SABVJC49U/lVRC49XPDpkndBnLlp/c9DkDfdZLe5YFnXb9CIXhVZOruy
-YB0zp9Jkk+AuMhZ+Ghs6Qo0+WRk6gsEOXr94382tv4WX4bs5KP7FOzVmGD5im484zK1TAmL0ePiX4aOv
-Hj96NPzma/e00SYSboiiAyA/7U/OF71T0O3Z/C7oSfxiPSlOwWIyut6OYWiLjPFVUvCWpsNZ3fuGn+ai
-rGFOk5hiCXLSoSF4JV15ltRFDKv2vTRtqutWmkaetWDbYl1xOXOz5PkuI+7/S1SswQp7to7iBZBabQXU
-CptXgCyEPUEd6rHx1sAIxJvq4wmJEcuvL/+ymVb+01jVhj7Nz4EltIBZc7NJkAW26oRjchvXqhzzmGjj
-lMWn0azJV0QZnbMco6Rmk4IsQN0xiZTPoJB+YAL4OLlUF8fB5GGstUn7NMaFg3w05Z5svl2W7y6KLJzj
-DhooMFDdWghg8jopvZIip0nnx4b85DPEgkmstbwoSVDB1UJ7OnnWKchQxiQmK7Q654VBnpS+jeO94Eer
-eXRBT8vmDT6KshUEE6E0MmI5L741NGtY5DaAt00ubO5zIXmICTMV7GgruTLcu9axCUfomqjgJmpx2+il
-Pmv5l5URn4qerV6153usUENrhORez1yO7Hq9i2fpBqyfxTvSCtDaK3E+bS84O+oB0Ku9KgUB4iTbsXZt
-/S7hoRPpnY3dqQe3VzcyO/1zPxwd7+Memkgbm6jhoZx7syLVMevkeitHVau7+m/H050MAT+tTIGQch+N
-7W4oDUussvKavdyjTe4xrTZocIzeU4ge0ZKaxiCwmgXL5NblUXtQsxvr1rLY4HrgPEAhuXHktqbT/VJG
-VMguulzTdV2DjluYwEjCDfOs9HgUBdWGyrhSZIDIFGvOUNA69WeB9WGgYHxAXbiDdbGJHbeu2qeDDzmL
-l2gdjhtdYhXRpZPWKgqcYJH7RGgAS87TGB3DK8dmWQbyCKMWfMsGrQ0BhD82/8WObkyWPo2js8knH11f
-UKxKBXJ2K9qIjMRxa4PEFgZRAjZt8T0DKcrKkiWUSK2meny39n3qqNAfvdtyNAQi1VhGycLpDiRV9fn7
-rlvlCuRja69Er7t6V6BXip6uQEJaCBfqAglMSwZQyQASjPgqQIHkLaGck9qm1Uc3H99oyBH3CwYDiksl
-lDc/oELVECrx6hm94d+Fl9Adk2NHhzLfhBitJHvNC7bNKUgMgZun8W6TcGDD1SpjK1xMSiEGA5pZ3DNm
-XdxgbFoI2BmLSIwiXa1wSOUqwwfsByrvCHgqsMTRal1cpNekRkL3GKYWsXLIVKeBfFGqj1jqukaEiCLk
-l3vgoLbvemIuDSiJgdUVMHbglw2KJZpqgMCbgs6Vi12q+Jvw+j2YEyx/n2LvJmJ/S2E592md9hscnaEI
-IsChOyb+p2G8sng59murt1DWjBnRvLW+KFZfIvztM6KY/K42ONH0OX3ljaZ4D38Nt2VDW1JiHfcOqPor
-qNStL3pe5lVRlQPposE0Kf4oSj7hVi8v0NQOPsVhjnBhuQE6152ugGGOb+qDwQ8Ml71n10W1Fj7tF/DY
-VAWntFkFn8oqFAJFG1dzXMLEZy4Y7aw0la1cEQG1hk3AyugECrTa1oeM9Ep3yLWiAukCK9G+Jy3vDGMd
-C319U0eo1UnYTbpbfVwckqaWYxy0p6ofD2YwkwwGFNHkuQM+YY5ES621EjCFt0k5h74ORLPbN4iYpPgh
-zaLfcUnH7+YgvGLF8BTIWuXK2hmEi4UjyNnVf+B6MPI3wzp8VXA2p/VX43IwU5xJYUhFzgoLFASkI5xx
-sZ1N/F107lkrmPk1CxckHUgkAY/eVDik5HqEIMFN0C/Bv+rTmSZ93LHuNgIWSvAFBkwxZQbwjfDhXiMJ
-r7lwxLRCKz01pj3kEiR9mu6hM5EErvCcqjSuhjNesS6iW0hN7pPi5U+peAV2TuFR7nT9y4hix5uopDJA
-v6yuBBgwalD/cRyi7TdYL6fAiDYhxXchFSxNhgbEEmXfEyDYUqXlAX5HijNpYk3OhEsRo22tFKP0+ajy
-lqHr3XrVXg0tX5DGSdQjw/nThGFoNy07tmj2QkrFcQRX3O6yzv3oQgh44ALPkHM53Rp6+uiqvGQVLPEu
-WPEcaDnBsMm8AV9NpZL6Q/XxfThZc2h6v/cbI5fQqF6L0W7TPOIxjwN83rdGJOzkYysRgginR2rZ5hni
-MTI/MFQsSaaL4yGDNT1yanQK2poqW6natzggXw0Jkk34CYhkl/GzJNw4WKQsT7qFxQPEgJJiziGtZRpX
-gYry71MAeqNtEHJ76Y0YoIo6ti+ooE2Suj8aE+PLOWvgVSjGmWvLvCydA0lSDx8Rb0TAS+XcrRphEhST
-HnQhgWxiDTAhpipdLoH5qomST3cFy36QhXtaCzmR0Hs8EUKYXIHgER2lS1HdoL38yJbFPdAVQzGFrC+a
-1rWiZFkVA0x54xOL1wbxbmcIuD1urvUGZIM5rMlbq8uH0PXFWDyrC6TU5+iCpyVdHVxzG03QvAoyf4kW
-xZoQ/9glMkCbwUKIUW4TwFAhzNkCyQHY9DJa3Y/NHx8YTCvAD/9+/pDMk/WnD6vBgVrY1t32j1QSFAsY
-NhilLAIEz8L5umZm1hro4dIhLUwtnJqxKRrlqN4Ck4fl3M8EhmutnYnSag1OgBsBtY6GKlijgQqUgJ8l
-Z/gRkTtFTRV+FMcm61M3bytQCMu7hv4LCrA1WfYXq7pCId60e2REAR1Y4gAA6n+ruhermgaFuwyiJr6x
-5RtbawbZptZMnXNCmxyJ5tMQAtxqkUOJM81TUUEZmiTKZaHHY9NcY0zb45ojGziuzrurL9ealNO5+iPr
-IbT2x3Gt67PpNoQ3sHrnn1YUO6lewiQ/WUSXFtnLgW2Yb/v0TJ29eJ+qwxhipSgm9MBZpPMdaBuFJpsO
-bsUfgh8drDmUFsci5cjQYACNBeV5nOK+L490I8uCeIemVUjyEKBUeOJaMsQqItZoSEs3HlrNNwUGZ0ll
-E1WXMCFJGJY6Du4fkvZAFj4d2GzA0FDbJPUQ0K+SIv1HxK4E7Yh36kvdD3gDrPIaFshqTW443bDfZqwo
-bt6AslGY1rmxVwP1Kgiu1mHxPuXl61yADn2QAT6P05zlhdMtMqSFosicLlqQfW6d9qNFnSvMWRwjnUr8
-cEItsmm1WmCjJwA66llde2YVizrlCloA9N+gSQYL6RJGpEro4GsKTctj1H8IMOm+M6i3FCxcIRk5DdIV
-i0ctF3wxISnJs7fINOdpnGZjDGOICrTecxZfMtT7cN5QEpKphLITFOQit65OxQHgwd9foZTlRlSEEYa4
-koRrF7SxtyyPVt2cd2Bt4x10+UUF1wYh2FC6sKClJKHJNyaG+gNDNtb98iV8vv/eEISqSr5dXfDTJm//
-+r2j1zc0LzFVqYMw0QrultypT8MEK7rZBp5rwZfPo+WyQtdLPPh55LxKuAi3GPpF58v/lq4TI06BcRTF
-1j895T/zgZieebo5Bd74kc2LUypv7EZsC1GIOnAjh3+JkjLIDMBEmgPW7mjYmEYzID0dp/DERWqtP/Os
-R19/7ZZRZPqnfmqG/BhgoMGg0VOYRBvcMcjx1IMw1sU5FqLPaLW66RNNCxsGtx8Ufj08Vf0K9KAUGIF1
-k+48lCnzqLjpNJa95MeiADBjsBmbs+tXyc2kugqYa41ZBw8UvIXaNX40HIIpiOD6FZfylWctr9soAuaB
-wSIb5MVNzKYadERdXdxGtLPVhYN7clOd6IjQhjAFjYcj08NH7mzwMQUSsD0bJ9R27ftsVh9q3kJ9fmFe
-qQ/4NxGHMctzV5y+FWZfAqeSlb6/sb6PwwgP/WPodbwBiSffCaoX1A6W5HZXDObhaaWhU1n6DZKzxWNE
-c5DT6SeUoSgm+VoqdsDAcmsKtOqJ/2ZKRPF1X1m7VMu8gVnlxc/WDAgUsyuQgy6MMxYu0KAOyU/HN8cS
-EUyK542x4byiL3II8TwGfhng1myR7eYFPAwCi04wlG/LuNLHrlzdnMdXwPoRMEDDR4pJdhtP/OdWelY7
-sqdQ7Nw5zx8602H/u9ntyHt8cOGnd69H566IjuVYU3BNFZPhHU1HyC7qDx+ZHj6eubPjI/pPT/1zv0H1
-nIl/PuBf3Yl7/p+VwXz+2+OjfhmnoT5u4K+PBkB4VvPto6NvH6u3bQj5MhxejOaPWpDwpTMN+8un/Zc0
-ZY8O7l2/7z2XwF++Mc5ny4vH/EXrMJbL5X3G4Lb/uDfowPbuHkZZ6MiQykJ3DA+IMXRAKoj/u7iEiyxM
-cr4RhXrku3AZZpH1uJ2cw3OtiTYC5Cxm2tVa7+pQ6XkJmnsToBU/F2bTC9QP89bsIrp9dUHKNI9J+MRu
-dtuufhqfNQ4Jy5Q5a7LicpTwZPtQAo0iSz8xj5RbaWOjtTXPwt9vLHaNFaJL0rxfvbCefFdvGOtRjADW
-CXPm8dw4Ub6Nwxs/QZf9nHNtsOB2y2U0xwNTg4ZuKsM6HhiCOUBdj8FQrLl4VGAS9+ug1mGLfsnxCBIc
-u7drjh451R3uMeEs3qQmiOwjleeHpidTRbEI//2R7ZOmOlsa9ML/JA0tY8hBi63fbPZz/DeEj/xFPn8D
-PSEkbIDnUXDFdDlxoTxkg6t1NCdR+Ohb8676GzGDFOxvLlL6e8SJgMasNPxAppmpNqUBj/FgGvi0Qrri
-jLbYL8sdgYrpEFgIFA4z0EjceiGajmqR1mNY+FHnQe+zG1jH2OeOwABcvQgnuWYZ9Rw98+rNUSK/w8uG
-H6OnTX6kC0D3Td7R1Zu6T1R+7t5HrU7NoeYtVOH+tH38bp1mxXxXEPLMr8COA2XZOf31NbCSB6dRYy6b
-fBkWDWac6nqWucmSVzcjBXmLjdUPi1zGSdSHenSHE8NFXoLkaXiBKiJCBq+Q+SIzkmHco9i54rsGxOlx
-hOJcm6ZXV1PkOC3diK5UGi4ZJcOzlC1kpNkSz09vMQ0WCCxQ7yv1a8GMsi33jmhDiY9jQqZW/HiEHNFi
-1RVXH2ce4cFt5dfUzBX0FeKhsF1e1LxL3FVlXSHWaemjVRNa9sjKf4tt8sU1N4z+QBRe2xqteStVfJ5J
-lHTpuSL+Y3RpcFQiZT4TwaQ1W1DOXy1FWCVERIahNgNtZYttjm1ZxD57Aupa0ni/iZL+Jry2zzZPTrFA
-SzFKQmafzWWhU+ir4idvpcMKLhXAgzoA95y4MgpERMTU9ougta5hahpY422sgYVnd/RI2QDkXlqYGzts
-87rIenWKLcdc3y5QQFeHUFUv/l8CbJJqBoA/lwqIvj6bBlrDVc3SuSk88GPYmC57MES/JKkaQPdOaUSS
-/bg40hd7uCtoO755IBpND2CVIo1AGbWdc7mySlGeYOJQ80COcAghcs37Xk0+r6fQHLwl+fRKpE+DceUM
-3fykOotw8yKVAjXCyHIRDILm0Q76ymjH/S7kl8DaWma26u5+E1QRPArYFBHlfNKqGpjGl9t5GA+RAzSL
-kQiU0ekP6zIKQWYJVyId3OiLA51XjDQMirWX2oInWqKJpFcyxpUaQ9c0kAydHImqeDmqdVi1j4hv2YSY
-WZXUi1IREdA36ui52BRFYh629lVUp98cD5yLwFadz9aYxtHFdw8wDtVN6I+oqt88/RhePxPZKiqrDWz0
-63XmIW0WpiOhOAAo0IJG4NC7GIOqKp5W4QxGa2M1sKb2cPiX+Vd/GT3uf3ex+Lb/1VePF/3vHn/7Tf+7
-r74asflXi2/Cv8xtzx59s1w+/vbbi/5fLr6b97+aPxr2w28vvu5/9+188d0y/Jax8Ft71gCDcoxSjzzz
-K0CLWW8ASVtADvsB1CyQY91/9vXF2YeydVkoh6saO3b8M+LH6Hmyhr+9+/k12lBdTMBJCmzXmtDTAfmt
-9CZ9i12GsfbEfNqvMtFNOuMTbf51qPrB9H2foghhYWEoMWbPUflLpBYvg/d4bALo3FKpXuBJmW7uUgYu
-j3Ij8wh0WEAJBiEnqdrJbKQeRXTxFh+U6bE4WVYh5ctedKn4CLrwcKeUQwx8vurDly2r5F2aHmp8SImD
-5FqoT/Hx0k5zMZm8SaKRgUquZCIkc09UvObbauRokukqRNhSCxUYUCtRyjnw03dvrKdmpAq6dt7d5G6d
-sAGn2uvBL+ziZZpt7l1u8Ab47VsGEiEvfgoT+JHVqxrE+1+FqIgS4KJoS0GzzYaansRmGUDgHeAg93gl
-+mkYc4biIIk/gOgTT7XJy0kgesAYzUftec4S8zs5gEywMQAbiyJsH+SztpRywnlNZSoVnl6GURxeoOcA
-p0eV+XC9iX8oiq0Ywl0ZHyVsOtc1APfHubA+kDu5sQ7R/22uLD/34M7yc98sjcdy0uorGXdqraf5dvCa
-8RNGuF1tXr2Cxj+8uGbzXcGeicK0AWJLMm3kzmqpZVUywsuOf77AMIgW7QBTLLMyJkbWNkpwVSpoA8CQ
-BE5mO/8MsPAj+6oXP5JpVCf1aq0BrB2xbv49gr83sf9PEfo9idxEyjVadlqpOYzz1CicXlwXf3tHdz8A
-YjF9ccKzUUVkHV0wdIbtci0AUCf8F3jGrV0cwesBCr77lBngaeo7xZMsTRdToLdvBbqBiJQB/Yt790S4
-oVDCCowLyzEtIQ1RqmJdi2LDKh1osDiN8roH+Q5dngMbLbNwg8FPqGTttnjcK7cWKZ6RIBDXRLlNC4j2
-yaB93JboGJXsNqI1bZ4Z6AQ//78yz3+ZF4xO4z+l6fuU3J7tRCz40nEClswL9O4ixZqo0PAN6Fp6zTR5
-1kh4aDCw63OIXrPBvzGRd07j/+0pvHP62iyxY3IcsxTnu+02zQoycZ4mq10cZn975wkXEXC3nB8gCvNo
-bv3zpx91FS3lwsdgCg1C3pJOCNXKx+kBsfkBVVfAZ7WeRiL4viIuj5bUPQ45qcp04w+aPcY7AYjOkWrS
-hHZJQBcv2ByM1JVxk0xfFlQNr/pR20G0d6oRAh58bSs8wNDcD6Zeg6MiQH4aDWJeFqulUd9qGWNrzvO2
-8hp60+QtvsXUMuwZvdWRfWdqc+wgUw3gqL+6j97dWO7/tuovIbq3+i9B+Z8yAfDzGWYAftpSZ7emnsZP
-9Dl0qiy3o3AItfneraLjO74hKMiYJX9Fqzp4t6PqP/CPCpIQRI16yP/M4uY9/QkLGhtSi5iux7lj4dav
-0EkTzE1eX6D/8R//8e+tKWigsXTgGQLyZ68Q0axhIcCbP0rVhKY/hZKxpT+ber8wAEDy8h4dtd7ehIH4
-jB/0yK2rMKFz1Bcqiw8esmQZbhiApj7nJoKWcqgROVg/DiTA1DfWkqg4lraaKJAy+mLmV9E4kuALDuX3
-N68WThdx3pdIr7NznnOEt4E2A/+KbTwtgIQuduh+lbZBpSb6iStbaQ+qLX+ov/9Qa0DsjFjGW+zqw5Rx
-84FlgFCcbRJlTBKLYklCypDbXh8LNDYMZW11o8vRJkQpOl/V0hBf2e1NRO12El0pJBqIRHrywul6rX1t
-y6PaR4YtCrW2Uou9OtaWSOaTi7LGJonttbaAhwILyvrUdc18BUFSqaEwEFNGHd+z0c8GSaZH2mj5Oo8B
-Z0hNez8wWzr6owB3UYDivWbZDs/nVtNUmXNYHm9XnqrucsmstTsvkwX/oYZVOEKj4TJA6I80XIYjNJsu
-3/3BxmmvWKTjajav7SSr9tuco2a9BpY43nORt9z+EGJCQvzX/F5wQ19+MZeqhiD4imOYS2u5iXyV3Mis
-TDXXgG962N6PnlWoPNBvLl/LFOeXD460LyjLN9xrqn8U4/fLr+aSJUH52vcW/FR4ql/73QJ1SVG+/uNO
-P1Vjg4yyMKevQL1oPb4gP7g5XcaU8vDGfB1mDC+NkZGfHj9OgME5FMxD9+g2mqrF8tSjnvrYXJvoK0Mx
-2uP/Zdmjl1HaJ4IKg5Httt3nIz+mnFx8Ld/Ty04gaTG997t37kie4kpI1j3C2AwTfxVGIkFH/Q2YFlG+
-thq3f8j3YHxgrFSFaloj+HhjbqtjWZsp/RLG8n6vbeOyqaMv6RYv0qxfJHQXH91yRIN9QndKtc50zq9f
-SneFo0boiYPlpvLUZi/AEgb8H5s9heD2DNI4wX9sXqN7TszdoXp6q7ss1pIHiXuabHXf6DzPJ5eB3XbH
-sKl/ZajMMxYW7B0e6H23Zqx1KxA/rZUcALDNTrwr4TcwIdxIKW8qxQhDSmGZsTiw6ahxjp3YZIkHNuY1
-PIUh2xbyFJ4LARGEyRAsDD/8jO235ghfGaa+PtEPShLVvdaelg9xs4394ymb8SOtchmZL4uZG1W3U/JU
-1t/fvA43lRuV5tpbj1IkN44SGLP6a9UG90zuX16TWK2Hl18gWHSPa9h+nZ08B2hu4v4hXFpbtxbHB0di
-mR/dtj1L3Phm27rfQsdtJkJQQZJXrP3G5TH4qWSK7nbHd2KYXwjThkvRFDCz7klykW/H3bZQJW208qC3
-aTCkGvw9q1Bg1GAwoqGW298m0SLgWUbuRYx5Gx023NE8Q22OqJvOmuKtnseVfCOVBgeqYXl4tn677l1E
-nt+XystLO2sVjTRas+34OsC7156nG0tuCNq021S+k47K+vPGvZTN/vS+VBL+J9YjukGIv2pcIiQ/OAP8
-CrnGqxadJ7+fzZHQGuQDabnOT63MEuwjujYWo+Xb5AnHj8XREPO0EiUYetaFdgo25KHGmPePf2vGCcqb
-0KGx9oWgXzCgL4QsTRsCtcI8prPmyMPF4n1qusz6+KW7970gR/98zg04rY3cven1+bfkHG2yury9SvN/
-8J4a/XPnfTV3tgDm2g9Pn/2XT1vXUbJMrfAC73mmXJv8KL1ovkgtzEBk6ajAUJi/v6KUWlwqfRbElcty
-uCSW+MZfd2/VVW9dCeOYqCLQSOTuNkSeNeIvenv/xraf+emdK6HlmjL98ydcWaZ/9OVbBwOTEf0JAZCG
-Y5PExnj+RSvklIbMh85HZmyOSbUsPF/7RSuoxKvqvI+mscJDJQtt86KFnFlX70gD9tp4WuPdJu8IP3+l
-RJtmViE79pQkaWi5soGEXf1oVjXwY5p4Ehq12W41yyJQ3CnZW160XmRFxpaAU0ibM6rIf9wVZ2CuKs36
-45QoRi9uioV6dyw/dO9EybGLn9oXaKWvWym5y3F6wuz2rdqI6vK27OpudApL/sl9kXKk8p2T0Rjk/xOE
-1qAvsaph4AhKm0/alrHo/rgdXL2Fk1W1Fdyk9YS4a9tQXJRLWw2REow1uEU5UsdcAG8Bwheme91c0241
-lc6i+VpJ+qkEx7CUKUkFF913xPxTu6JoIMZvng+tZBWQKt9zzOU09nevgFEcAbV0l0w0scVKxftKQ500
-ql3TBZz45A+6auqEWOkpoxtjaYoMghKjCGUA9cUN3bBwp8wz2A33FXJmwbYAQzCDSWQ/X7IsDre6aFuF
-Wzwl1rzaRVZOVR2D59M0ediYRjj3v7cxE0Rca+CorBO1lLyC4dyDMxuuVJafFn++1pXi5NjXnYz8OFNu
-ETsS573AUukpy5F5VhUUTESs0lkqoMpSHMb7bEJIHw3v/m5urAjrrxWiskqq8izKzXI3cdVpVCNMM3uk
-dsnvhztS+71q64x3OdDVNROSRQOwzLgTgciO/Aiaz0A2eq/bC1XoHAcgkY5J2fBRSBS8OOvGGeCdGpFB
-XfwRTlupeF9OKzGnT385X1W2y6f/j7vIBUlSM3cTZMFPYhr2uTDwzAq0FG/y84An5eWM2LtjZ5QC2rAp
-TvBmFJXUw0PpTMwbAcV0QS+ja7ZwHhnSfeFHeqSiNueW1FLM/dxpytWHhPmV37IwJxWpMrt6Kc9ql3nU
-Ip+ElrH3+Iv7XYgrZg1rGAy4hudWSFN1q5xx+49iiZhKzz6tVupbI4O42bABXqJyz1lH9BgH+OdPfGtX
-prlvH1X7xJdlatNeadsYfHlsz4A2coty10TbAmlh26JR9R5Z/rDE5KhlF0uPgPUrv7RCaFL79bMOtUBM
-sXHRKsm22oUrCNutabHR7up2ERbsr1lUCTk27JjIDz+w7rTz4x3uvbRsrPRxbIbk4PKDUU0wdMuOwxxj
-GG0MP6rn7qlXQLecb3Ux+ceRpgte6q8v3h8plO/mqEtXKMBw8qT+eeB0CzwwbRXiqgO1m2t/meFtc3yL
-03YpuQlv8g7zmWYxXlC0Z9Ry1Xet7HNoFhcM/DleXgs3OC5gTB2BcUxAEXB3e0Npv5/DdgcnNH20zrCF
-aa0lZI+tF6Hrn3ZXg/yU68ChTu/y1nrWV8PW+Iz2Lk3uCYMWVQlbFmHDsKToa7OBBxgb6Mj3uNxUdATS
-2+SSNjPxvYzEuyd9k8/oDvp/oO73EERPFdrx0igPD76k1aPuhBbL5NgS0abr/urckSwY9O/4CxGpBrYy
-fv6OGxD5PM3Y4GNujQZfDx7JVyL7+U6V+JgP0mwlXztzF5PMf9d/NBw9tv7GMra5sZ7m608sCXPPkmH4
-z+J0x288fJVcsryIViFe0mO9ZXjgD1q2TqwXi6jAjOQNmEALvcHg/2XGWHyD+XNFcOjCIrDID/7Tq/cg
-T+YM8zd8Ufqx3VtaygGdQiiCZPDBy4Lbg8cCSmdeHszzdoE4wF4+ioKXoh3tYRgwctd5KXzJsUtvDt9A
-+s/DwouDHchGfn+9t4Qf6zD/+Sp5I64T8HIoCkL4Bei+3ha+b0DAr+FvxhY7aOlSfX1Ll0qtsDgl+PUW
-8JUyE3sb7DgFFeUGvohgO+8CvqMseSV+X4sBRjn99a7k8DCvsfchiCgjp/cxUKhK3FvpFlR5RdKl9XGS
-+BRyWHnGNdMPVxnS9iJIvMsU5NfQ9TEby0do6zC21REauxOI4zXsGuc7nziml5t0sYvZyQn/OxBlT04c
-8S2ovgg+up74OvgAP/wE/3gfB/948fbdq59fBzYRsj1GCngafCSDA/4I9GsD9wqPubfR0kEdqxMkLnyF
-fhNVNAhyV/1ysPRYGJ2OtN6gTE9+d2+hKJl8u2AIVKQsvOhsN96BkQf18AqBOHaYl0x3M2/nJS60kLl8
-Bg7UumwkBKBx1gCrHm8wPN5gCE3OPPy31uz4IxIcNDfH24LmRRUHGV8sLJjOxlpq7iBIJszfIj6wchBs
-J/TNwRq+8xTqas3svMi95StEgpR5/LF7gAkDwsBOXgT2W6JzzFwD7LC4Efmy6J6CJFWZzi7DeMdsAJwv
-C5q/eBHD3yj52BwCTiRHvDpxJHB19mgsZhgGBESVwDBdb43DEk0HwVogygIqdArog7LW4nwD5ieyIB94
-+cvFhmtoiLzQvd1NskBNC2IBn/pOBstlF3SGiI/Ozi3WoDlRFiPU717gFfjOC1fOQHbwPuosQSAg+zfH
-fVmOmzcbBJf3GjyVrmJAPHJpYnVqXzpRB5ZFxEHTyHhckvChjrvUm3uxezsPwkk47fejmQ//eHVUTucz
-KkfYxB+fh1BMBgngoMnVtgpk+Z8N9C0j+qr0PXEYzCyA4XNmiOTOO0MGDt3l7DMX3YqYEK8eBKuJ/HFk
-6dVgOjkRazERq4+oybBw1KAkvNW2QT7KEh2tC3x8gMrYLAknGCW8a/DWYr8nkoow8i4qbjit4JzVB73z
-Fzho3lgQLCbiOxFiY8i0zHgLzi7YnZyUXJDeuRM+FX6GpAG0wbnPzzgZIEER2uTm/tCOmtBuEFpqKwg2
-E/71TlitHfSwCxrAZiXlcGA/qqzlxPBI162AW8psAVFn5N8gSEIvAKhuJuXVo4XbCfojv0rTZRtQujjQ
-ZEbJZfqp3hXt1gcph1rxGe8REBaCl0ttCXmBoiaUFKbeHDYp/GRazFxxUDRBOqLOt/Fu/sk8zPb2LGyL
-17/C3Kbt9B3lL1DgAJSTTNLHdOZ/nGYTGxmD7dt8CdizWk9SIlN+0oKk7jSbAY9L4I9gn4pIOkMODTb5
-SwMiDR4Cl6BEFubhEK8b0ENfneLk5KNU6AAcmObpcEaKB/zFSecs9ck3X3/9+Gu3duOWwDL+9BISWbI9
-jo3EFTX6o9PhmPOkW0yrg4q2jw89ksX09SDn18SCOLMvJjVW5Cfj8AwVZd4k6nXBLW8y8VRH4YEY1YBe
-EDKi5M9GRpTcGxmWCRklLv4NVDz5fFTk691yGbOKyk7ud7Bphp4uQp7WCBe5WQbmV7pxMlAXPTbN+qNZ
-wGDJePhPkEhhlYcYwdq2eOrKxZNH+302SaaqdYn2/siFFSUhBhC4qeQMPbVlNoRFIPjxJ5MVUuEoiQtm
-yLLkL0oET5PZAfkkbuV+f9MiWz9VOBKxF6fJSaCGEnJqNohz+oU3zyLgCFHos3IqsfwB7/GshE5prDIZ
-yGowO4X6gfSWAdfgRkd2xgCJqIBxE0oSHpZiZ9l+z+rv+qOD5Hmcs/cL/hcm0eYKs0DsSxNiSzTqeiMY
-xaC7kRTJJqXs8z85mVuSVVHiK0N5Rh45MCaVZhbC6McgjL2U2Lm3o9nB++O2MD0vnfoEwTyAiUxImyAH
-J5GAKipXW6iNj3x0xvpUPKNC83SHB/FrhdzbWg+9Hu9jRJVw7tiCrGaDSp21YqS08DI5+MIFDA69sNSA
-w7NorHDUC8/OzkA4lHZaOnOf7CZRkPZGfhikclIjXIdFSpzNaJ5PdMY3EfI4QYW8aYtOJJ1r6gwsTKIS
-VMVB8tGyj35nxr6EWjE0Ny6/+Eq1l2o9Cb4sR8aDB3/gTxF+auUrshshjfnPgvOW4cyXQ/SQZ3D1hKzD
-tua08oohOVqjIx9ZD7SDHpM/AlPJ5jTgFGdTbwtv6AqVmzABmhxaross3d4Jeg3cAxH4ZhtWNHeT1q5N
-NGcCP7X1RSKPa9dUjdOUO5kL8Qg8rqHG4nIqiW+/57+EVEByLCahXv0n0aefaXZIRqrtMg6LgiVmtYjX
-Az5ACl1UrNNdy8AX0XIJChRmp028hnI64hOwS6LfAv5nV6dCXOg19ZUkcgZitfAKUPulAZCp1YSVUDoA
-p4IFrwvenc4hoWWnmIBBHU7DkmTQJeOXqr0TAkIAlU4oeZ4XCWRN2YzcJpEYQ6phS0MBDstRCNVG3xH0
-R5mpc0YVm+pDU6UfaeJSEJboJXFNuvdHZaQZZPTH0vwAijgLUC8mqMqZM8AkiZAZ57QBnskk6Gg4xq55
-r79HWx2JkpEnASnejtQPyu5sPnG2K5y8DiAVfcnsypLrABWwcXKWjVG7QpsgMDRj90pJWiAkPGldjf5L
-Z42Q9rcHJWyE63ro7UoZsztjY4a9TrIp0sssKOBfn/8A5gm6HX4ZzSoOEGkeGjTrau/9kVB/K52i2kKF
-7WS3uQBLSTlxM+VFCirClQbnIShoZE7QjBhDo2fZRFMIdz1kFOgSqtuwblXhIVoSUlhhAGEX7Ssgxkpj
-Etz+1WcPnDuFMxzzBdkVWitBcKG6mlReSRdZ5ZGuNjCw9stJ7PfHHP5dBf5dBf4Ms481IG/o5MGIHHjJ
-fg/iLxgicaoy00ez/X6kwGBB9ULmOYtixyn6iXsKXGgo/c0lqaPn+2wHsE53vR6Qlpf0ADVKcSGUvdWX
-FzJ6dCSaXAkeQ6R+QKRSkSD4IAf+QTkFjKsfDbaafWBy+ymSb3FaaHBii059n4O5Jd2JbFZeJrlAvUkX
-4HpbbhQF2qaREB8I29tqGYyLkm5T3sPueA/SK0msw4nQvR9NIh/UbHSchFlNLboHh28KlERP3VUcgYY6
-xal7Gt+3U8DzEClcbpWUs8YdtbZoztpgkuALPEqV55ieV+7jYnhfbptNkkLYA8J3jd+J7XsJeRLYJq2q
-uaWldqvM+Ibzz6ttJqIlp+FHy2ymZBLaGyj8gSUTKwamlJjrEAYXLA5v7utrk70YYhuaM4j0Re5ZrkDS
-gfYWLQphELU+etPEG83aJh5XIDWHc1cUBm8BoQk9HWgMIQgpiEewZB1ENFem5vriSwNMNhLiuSagjc4I
-zQ1xq4NsoVwg5HM2EC8dOQ5kvXEKmrNstIONQvdpEPPel0HRd+J+qrDJ+A6xtn3iDc+C5cShy6YkokO3
-HFAdJNcPscciC+mupUB0GQbaRM29pYvKHJ+LC7BX50eR56WGcUZNUMNy2A3cVtHSD8fFWTxhVaiKPu6k
-cHbkZYQoObQIhwazHQfZyUlH7YowKFRvBIYWg+Sp1fVSGm5q1PJANJVO9SYZZ5MCN3g6Q9q6Ny4gkHAE
-dcGXEm5Imw2KGkqyYJoolai0WLSGK6s8482jBZbmrI7fpJwLw2CksC20GcuC0oQcZ2e4PPp9twCgQHms
-sF9XQVmANkdQUEr4O0cpNId+8mQ0aeFYcrsBG0X7Pbja7/U5Qo0E1o4QNmYZ69ivksswhna4OmvzFVag
-UVTxlyeudMRkLm7UCFunohFzt0Rg8rkX2i54puQHCPFSOclIXwF08k1xhsoUyADcDVd0y8VklP2pnVAf
-nuiq2leUgGFUtHQG2nym9ciV7Kyh2SNRoGY/C/BPBWGyXdwf2jCwkhe5QRCbpkJXnnD3wjQl3LPJd/eu
-wbJcmGTHU5OAqMhk3Cdxa3sn2GeAlpKUztto/skAOqHofrbgU1i/pe0N8NFQURfGvmiQ0Bm3vDZR8Qc7
-Uxq8RKQyMTNgd6g8cLLbzaqkLS6zy/9kFErXMO7sS5QqjWcep4nRrYfTr1Z11Z2YCE89egn5pDuAFvQu
-kshvY6/YEHRKa/+dwcGCrES3bIa4awamyCk8HZ0WWozCfi9cX66+LTmuBiVRIEOiApBgWuuvC1i+6rVQ
-wGPpKsXudh35u3DLvbv8KsJLjXfuLd6vYU85U7N4RNfM9kuY+CM06iolX5NBrJXsBL1kUsC/hT9Ef6Ic
-sg9/ekW1MopoqFp59n2agg6SqBZ7hnpv2erF9VbrFFbuLsOYlkJ8QyNrFacXIWJWfKOQHqBJVFioqPpB
-1vcqSTP2DLrBV+UvNM9tweuV2Q+z1nhWlFiVlBuV3C0SJm82jWal1W0x+lmM+c5ZgqsrL7LdvEgzULoK
-/TdOYghklIJi4lQYWgjcLNQJIuSbe6pACgVSvUCqkYDyVHoyjkmqrENviSELiACJeVo3MzsIdkTjcyXX
-oehcs3PIUzHv94Eyl8E7h2JWCvwHV4c7dscU86VkQ865C7QohWaOTsl5r+d1oAH+sKCH2FiOjeWiMdfl
-B+2g8pJLm1ztV+sVOwCOKArDmh/k/gOMP906NHr6u0QbPspf/LaredzV4n8nnLXSXyu2UYO6LlFxsHBE
-Nr3JYl2VW7BoLypPiRKeDQTpq1jtwHNgeI5mAyPsOB1kQsiLBniICrUaMQK+/XJdVYh4rebkK8bC6/5c
-d+xVQiwU4z14T52prbzntmdLAoWvHA3whTMU+ILMAf7wtW7Pqk75qR3ldi+ZBQYHrGJzQaBAh7I9e2Yf
-+Paa5sIvtUOQZZVXrfjryCmwsR/GbPJZl1dqSY5wOjgFGtYXoqHJslqgmIsA8iVu+xjlmXxHgQCdKH8d
-vnYoC/DLOA1Jc+UTA8/bxCFHM48kQJbNKwjm2zaVnSFIK2HqNRl2gy5e72p+kspWUyD6/LsMjW3rVWjt
-HqG9xQ6Q20jc8E/SZ+I6SsP+AcbNFh6aBgdt88jYOakAeP66YbWWHkW+mjWvbuJylTnRVWYVEbFzK/oy
-jyM4FuNUcO8md20mPepoGafQP30VgQjuQ3Rj9kYyxOBVcMvyebhl/q19Yvv2SbjZjmE1PcHvcYFfz/Dr
-Cr927S58/W2X0vMuPv/y+tG3Y1gu41eDXcJbCqR277wa8CdcTrxXXZHDj5YrCAy7JzR9VXrwMY0Sx7Zd
-XImevbIBT4mhqqNVlQVk5T3Wdnlt5NN0qnJq80LwWJZv8AszqxAonti2D4D1Crwem65jcN5DDdPuzit4
-ziOvaJXy80937WlYnITHIkwC6o9NUR+ZO8nU7nbGo4Guo+pqfOpoVlBle0p50T5ShAt28rH0veKzhhk/
-rYS1N/wDie4f+F1wVXIPKM8ZspqDoLnXQPRyz/HVwmBv9Hqve7atggonCeiIZCzIXJHvxNVlQFFoGsMT
-//TJfzrT8/z83aw3cf/z7HTl0ebeNo3F26D2WhAUvOlX33AgfwtOnYH766n3fXBLpN4Fqjk/hy/wD3zL
-4BtKn/MEvqBg+g/4W+CD3aPho7/AD/5XPPhOPPjOPnjPg9Pz8313f57tz5P9ebHnVfif705X43KgbTG4
-QWk4oRmSGXDj6t51vmKmTibW2H7/mys0YA8eaqiqvZH41R7P9CW2fyBWKA/xsD982PaCrj1O1ALRNn7J
-5vF2XloGTvUCaVhF8FhVem7YtkTE977HwCbXy4DZQV272ztPHOfDhyIAZpDBigdZjktq0u36H8RY8bXr
-QsEugMlMFVmtIpQXxXey+BjmubfrwXSLEeKI055yhRQAlCoIdA+4j/BME7k6bdyod0AM7ve3B/cWmwp7
-9gH+ouPUxnmCPj1oOeh24c/H+ikcwrhn9+wtKD8VWUXQQI26ndztguo8Fj3JzaMPW3wyLrKbW+66KTmK
-BjAaLDCpH2wvdA9zPOkOdsEtd3Gl0oAKvfRAxrcUUjC5Hxsu7FJEMo0rJFBSearnskWl4MCENOFBbq4Q
-Z3tzMuPXYWRUQfCgDX/rCJbzu6kYZ2pUbqJX8fmJEGSpzkeXlFHQ+AElaPXAnww39XOUIvk6WuKaR38Q
-/tkiJZN04W+qwoUzN4YOVp3hJg2GmwUVflv6nQzuWAFDBw8xSAA6tMkJ2ru0Kvf7BaMbvLPpcFbl0CSf
-cIh8VwOAR1rDwVBTf2AEAlq9F93PqoaljUIISeFX0Zr2bmlO/Gbz2uSRI5zUNB6d2FZY9ItyyOXA8VTe
-dOLvYUfcgW9djgaj4eCRtZen+L72LDrGJ96/THfJgifutF4l8wEU/PgbvsEDgKfisN0XD0+143ZMbl1h
-hEykHAF0Vk4miacTdDLJtJcHofoh7DQvhgIcBtAb2eCBN0fP3BZjbZZ4qguhtr1FsJWn7tbwlc7kreAL
-P5O3gW/ybNxNMC8P5V3Cj9qhvItgOYCXG08LAWS63gkc5HqwTCjsDN/QltpVcDrt9WcTZ+KfLx6eD/bu
-+aIHP6bsxYxewM+9eyplzHsQhu96II+fBae/ohzevXzx8uX59dPhrLev/X4AxV5DMWw6f+g8mZ5fnf8y
-652501/PZg/3X4Icv+rPHrrug1PvE5R74pxf9Vwoen46OYNKT85Pz0dne3z9gnqbef7t4TyfPYQn70Dg
-T/xf9/7ec3kH51MXAXuK4hoHAALo/PRimWTFbL+bni/C/vJp/+Xs9quDC8U+Bqf29Fcsk50ns4f2HjOs
-7ykDLXnu9n2Okl4rSlaoGfza3+T9U+/H4BTVEujj9xm8ibwfzHNQwAz+Hag6QxcUuqR/08q5t06orunE
-jGYsYRkwVMxTD1YY0BBSIjyQ18Tiw1C72Q99Kx8c17vmzxy0Fz/oa73Z/MTBBjCFV+WxYz//+Se88AKf
-QfdsYXu/eZ0RulOMxQlGXsT1oc0Fw/twqZBjNy+fg6LYVK2UaASNHSTU4FqLMbjli9ZfeprvzL/2KNOC
-hutEql2goFCABXM1rkJOL37VWWmYc79uFIAVRVgGELOnGB8FLPqs8oiVceF4JEkcTQseT6a0e8co3HHm
-vwbWyOYwoV4n2u870XSEofzSr4Ou4QEfzcSBH5lLhyugOJ1Q1X2DKOXEOzqHBg0JH3TFj3zN40sTmPgN
-y1aMc/FrftfbD+9/+pFqegn6Q6V/aJIM0iuYOnm2GYDyQ4q08z6BcpoXvLeTk2swZd7EwLfL/TO0kiPu
-ubquRBxCt5g2dCK+OJRElI8qLAqo5PG0otqMoFqSAhXXLiGLpo9mrpeenKQim9xrTOaEY0/BtgdZSQWU
-q08iiboSEb4jkjJ4nELFJEv8Ylp+0InoJz/OlmYB41JJaUAKU06lmmyUF6925voVfGDohFiKeJKKqb46
-wv6v9q++epUOmfyGC3sTfmIihon3CEtcVvNt2xOBzCBheeS1QbquSkkPlQHvvs6CKhY0o5mUUdygag3P
-+KOpNvYem9EUT9kMxBvIL+BF80+VRrkiolNnhcrxSF+pNWHaHuF6LEN3FOrlD9wBQy+Bb+Sz19yDQPUZ
-eY9oGkwjFcwSWc0mQqYMgjxhuHi5kkKCuE1JUcN1Vua9aeiZAsjbGmC/OXhaCuPrjhQBfgOj/c2AU20e
-vCQA08ihKfKHlUWmwZmcBcOTk+IsmdAcgjI488nfvQm3JvTUql/zM9M4SM1ObBoOHvnscPigIrZiT830
-ft9kfZgpiBOUv/ZQZQc4aSvX43oz/aRvB0/pNZrEwGfwQmz5Uon69q8MUyE9z0u9EBU5FWQ4nKEBCHrc
-yGseSAZtTuwI2RfcU1oKFDztPw/ySmMj2dgjMALEHlNZYb+vMA7yWucYZQQ9BwHGo+R8MfT7sTvencXj
-WISKUlgnclDVUzzTOHTqsgBZsZcFKf7J0cxA8E5O8E+duWfYMQpeuZ2Rua4LUgr+D8NFnZcLBBlGOcFZ
-cH31XG+L3sKQsftAzoMz9yhO3Jc8kN5m5fZ3flBz5tyy6y36QX2b69FgaS57Ffdo6YI4PX8OGpkNJnrp
-JTYdtQJFHDB6jaHx8G0HqxwlOW9fvRA/Y+C4By/K6fJlH8ZPnOKXEDSOkbdO48XbBlNhE8FPsFSv50tV
-jM5ENnkQHo4iB/yk0+9rNf0OYpOa50Gd4QCzsLjNqDlRyR2rCmhfMYwVg3VeafRsiJOLvs00vmS/oJMj
-9KbXM5cvH9CKVisGVHHthK785dhU3XYH6XKpfmAYUUmuBrahb3/A3COZO7glJSjHr2T10DanygYoj0Kj
-9i9kAbYJrA6SIUN9jVuKWOM1rIosmhuqNDdYmAv6jtqEwR4pC9UR8ch6tu03ljPq6M39HzaZT2/EsSl3
-Vu40+/I9gqstoAa3T7g+q+9Rl9jZ70uNhXMTjiuHaRuK6EpCktMZ7clJ51Ie5rK157arvdEraOa+DQDL
-Hz8v7bIn4YhSh3TgCfR7PRB346Pq+SOIPOJTqMpaSktXsCQ8SEW8HetRFPu9VgqxRvu0LVgrqJlC76Sy
-tcow/KtSi/vOeFgYEoFSoyu6hsd3g2k+hEVRJohxNToZNwVEQYEf4lQO/AsjCsUuwidlO0RBBzT28lBO
-BhJb3GYlNGUnQxV9hsGF14OLXRQvXmbhit6AMgYgRtAKrufIFVYbGYdcC5vOMEQbs22iak2aCg0Ub4n2
-jS5BfIPMsrxHeqL/oLN7YgtwkvgNM4un7bgmHwWF2ZycvOCWRul9furZ/8sumfpHz55pP98hfwd5pOSk
-dJXavcQF7RQIjFWD+Wgw9JqUQILzn/pEJjKIP6LFlRgmM6lMJnljB2Adv8G2wH7m5wrUAxC0EcfHS1Ao
-ZRSAx28Cu97EtsuzfWAk3hyzR/1TiEv7p2iepXm6LAYAIbQHoiwbhPlNMg9s8kugoxpNZHhNe8HK2ZsF
-hYp6ALle90TBOs40+yr//uZ9SNdtODYBmhHSbFd5HxtoRJRxLGYHkK/pVtfowIagOJwXULYicQvUCWi2
-+VE0oux3dDuqxvILTLSD+xblAdSD6+BszQHEGB0lJg7MtM0He5P3NTL50fvBRTAXjC7ZMhoInFnie6Ro
-+R0snR/TK+mdoQD/yhODyeGVFBQMuYeQ64dh8JMw2zmjCPnu8jg9A1LjylumYvAxXsijaE3chefhLGX6
-pIgzr3vWaO+LIxgrRh7+uV9/x2tp29441f4FUNsFr2Of75ZsuTzfDYfh0HYnR+QoSNELKRoPxwQu7uii
-0C1n+xkyBTRfhGHsG9y4yGCrmWlIVXB+cpSu6k4kY4TF2vAQTfAEBNjwaxmQgOmEEhA9SbNPRRFj2nHB
-ud9IFrKR5pGSb1p8boInlMEw04IOsh4yVR8DdZNxIlR+CoMCGw4d+Xj8XoU1iFjpEWADR1Jxi5WH+UVv
-UUmqeK5BOwUXlKfgiIqys3ScUsRDRKelpumMJw67AukB+ILfqMxr7+GPogvpIYkwM8MKZq11+YBESmlb
-kulhUmB4dDDnBochg18wXdCll8IMcGtGnDPFp255iqtm0d65VMFcAxqh1VNZPDBDkvxRavFzdGS5SFsQ
-DZhxffXcs5oAdyGWNeCAvDK7aAE2Bmha1zemaURrVVQ1ytmUdn2AopjHghSFftUtBWS3MpwliwwbQ0xG
-DXAbvTzXVW+ADlhEAwQdUKv9QYGCf3s9TBpSHLyQZ22tem2lAS7Pegxp10QZ2yKDwVgL2yyNA7Afb1OM
-aUT8x4j/zL0e8G6o9djLwC72wDLCHhSfjIQRinWrOIq4BQ5mmzfHSQMDWMqnSJ7WAEE+pwieOpGpvVKq
-cY20hee38F9XnPXkBjzUQLAyL59Evkw/gU9iT77STsulYE/PJxIO199NsNAQC/khCryrpqPFkUdmXNQA
-0GhEKZZfNZaG5jaH9SBO66Y84jKEhYXuSbzeFFde+TUokBnoR4gy5LSuXlsrjQ2VC/Sgdiqk8y2oBYt3
-KDQSlcbneOYrYwtQYNu3P0xmsRKJja0Pt/no2LZHs6y250Hd4PZKcZ9tj1opue0hLABhp8mkHWywzEA1
-UbpcY6NR6IERaGJC+wMdK43jkxOJTSsVR0M1pwJ1ocrCYNiysKVOWcpdDaWp9/XQPVT2lw4H+E/F30kP
-KupuwgFriyhCEU8uAtBVIAMPPSX6FEEv4kgot75s8u8BjixbC4gisT6fajGgBUaezRrKGlCh7Oen0mPK
-SnGrjOex8gVrZjMmIRvR7o+0q0HmTjpDv/RNJLqh3+GZCYfcQm0IU5TYZ+h67Y+4gDiA1YZn8Vq2nTk3
-BE6w8+be1lt6C2/trbyNd+NdeheBnUe//x4zu9dXZwyv9B3p98BAn8F/rwMwsFzvE//zgv95h868p+Yt
-SkYn2513yBKHro8BmuUO+PNg9OTJ45H3I3CI+v7zDyjAfwt+wHhr7wP+xb3sn+SXn+EL39R+Cd/EpnbV
-9cMnCI97a25tfjo+OSvGhUgRSvsPFdWn0A6Yfx/Y8zWbf2KLPd8YgS9kRe3DXZEuAT85fQPt8WYvb/Lf
-05nS/SLKMYhlsV/TPfn7KAcdYh+DwbOnQwXAdvYw2mSP9A8L9wa+/LaLMuxrDi9gGb8J7On5+fWj4fl5
-gXvL58n5+XJme28DG/eT4TPYQ4Gr/mw//RUKDod9+Dccztye7f0SvFV6rX1le/bVl0D5DwL7/Hxq9970
-7IeO3XuLkZL8x8R3pg9/fbDv/Gs2CfSH3XN75jplh7/i35n7cOKenz/eQyO/QCN7+B+vA+9s71WA0ZLU
-PFV0nDvbqb1wXBjZbLa3ew/UMB57f8FgoIfufvAQKmGX3u+BjAn9lfrvUUu/quZls1CLv5dRa/+sVXzo
-8T/w6u/1V870rPcvhOWNwhcU+4cshr+nUACe/W9VNZBVAZAZjv2hjiAC4W+y8CvX+6veJ2D0Abz/7+D2
-1XNfPf9STpjrPfvx6bt35RsYX/nu/dO/lm/wcY0MHvL4WNd7+v79W1/r9YHrvXn34u/Pf9YfAmjPfnj1
-owaG7xC10lbUHjeb9gkY+vBfH3+4fYccQft02celLuZfIIOBnNqniwVMEkY/7F3n/Hzx0E32OsHRC/Eb
-XvdgnhXqaM7tCKBHR5g2zolv976HcT0QrxPGFvkzvr/n16aTz6ZfQsN+269gLHwk5cCqsMMPWF4Ld0Ig
-awA5k2D6K8D8QIB28P4LQ0x+vZ31zm8pmCThub3Pr069/8ODWETACoyNAlX2MIHiAYaosIKXipLtrhCs
-Z48jCYFZ7C92RZEmUC7yCiy4Pl/g9wS+d/fn56crLysUNdFagqWEISuz25H3zYEgn+z5sGApEdQUx1k0
-tE+uRdjDaxCMfcwb+I3yIKK5tN8nuD94lk24MAb1It08W4fZM5ByTtajGq5vevn114+++2afnZ2Nht7X
-3zx+NNyPho8en2AeJFQnfhJK4A/BzyJeS/cvetVfP0z139IiUqJWOrdALv0U3FK7/g8yX1dVbn1QuufP
-6iDcwegFUKIf89xw4xXMJLRZi2kGf0CNk9YqipLDQekPYSG0ZJENUZfNC5LJ12iZOJgjsRZWUfhXLuB9
-eXKyBci4s3eJFgQqyt4d7mNsFM/1ODFoN+poz8nJd/AsFqW40brGEyM8QCL4P9KHTCnAg1SGjXyHe4nc
-GYWn56oRFzAnnXC/74R6wIUORziIFpRDvFT40OoO0REiteza6DEcN6g9a/YL47l0Co+Ou93RB41Pi/z4
-SYW0F2YPJ/lpaGoQD48xoKXmC30GXCPnjsCi5c2dvamSOBoAFT1ng9/yEMbfWQFOV9zPjafayB0ULIIL
-7yYovOvgO76/y7wR/6Lt7RQtfklMK73B1jxnwefxaSHuEnDsaGG77gR6UBIElEHgKA9ObLC9B3m9sLdx
-vQ3oLHiFvt3b9OzuzLLB9J5L9Yuvk12/787xxMumd1M4czoXfBP8Q46Ljl0rsgGih5HNReC5B9wVN39c
-MjnqmLwZUCTUOxHD8hQNZsIhZwDvwapZRgks65vbBbQr9jFqAz6oU3+fMEhLjvx3z34wAibJF265mlEH
-LvNVq8cFHeov/R78/GQvQPPjDI82g175IyHl5EQE6BZTsG4xotdx8exigkkcpU5a9hcXuuN6ejGjLdry
-/a4otd9lbYvHXkSXtjsucdfpkIXF0ZNoG2wSTfpMVOdFII+uWOO8CE1Mjc3Niyq7FObXHrciSq8cpwjM
-9JBSWNcPYbIA+x4PbgMnLVvbVlrDfXZMGHFyUjeqRkGgcTdYM/+Sx30p5dR+/xwUlH+x+jOevkuKNu5o
-Fx5JPAcJkvdddIFJTNzaqe2+ytXBJiMfGL2CeKlPVP0ETtK6IKUnjkQ/2YWIdwpCgZFq+F38W+07Wgcg
-NbhKQb/clv7Wen+xlmJHi0gNeoUXVxK/lp6ggLYJlcu4wPMPaZUMQiCDZBoBcw1nMzq+HwF58/PQFOzn
-Up68PAgLMK7/+dOPQdPiY7Q7VJObzK07OlRE08TG3dgqi/RhBWCKsELuMfNg65BYnmy10XeCe/iNnv0r
-yoojjuz8I2JXauuAS/LvAv2oLYZV1kCdOMsg8RZB44W3Djp0vitCt7XmCIKfGNWId6nV3qCL6IKBFcx2
-wlWkefm2DmWipHVIDDEP9FRK+n7ZXAqpABReUDxqgkO9tnmLJnHa2ja/G4gzlkRwsGfphnMwEEiiO8MO
-5EO179jsVYnV1n6jBGYOiSGwnwCjtGgQQTfsnj05hd9nlYdWJB/bHuN5XQniGmYeEXtqke9oK9TgRS2m
-BcBFBTFA0REK/k5SbR0bBiXB8NS5MHU2cVIKeB28el5z4aAGJlw1NTULaOvjycm65DQ1LUyLsEByLmXG
-BIQaZtc9eKlIUlnttnSrSambFWCVNPOy6dPWVFkoZ//B9R0hWNUI/4RuxZCFbl3pHEfIUdN8zgGroIUO
-rAhQPQEimO6BebVMqhs3knuJXRpjFYRlYn5F8eFGuwbYHnBpvqfVqgbTxslDimZ3b6WYTGnHzh3V2JlK
-HaEMR6nUpAc5bPJm1Aeu6dKfMXZVSxBpHQHl6iMUgLoKw13hP1zLDv5LhpHUVUk0LZza0qwyDW6nnz3h
-FyBa0mMYdJFV8IfwRZRCttHQVu2prDTTQihWHIWa427iE/XsucsDzzYa2vKF69LQUvkKo0KrY+JrIamr
-jlxdAOWxpvbjNIBazp2cBInOpgq3XtymuErj2ItfAVXlwE9OJLjol5wFauzd7v7cPm8fN0vI8Woat3zl
-2b70z7a08tDzr+GVrOkNHvo27bsBndAdwCyX5SXN3IChdMUuPkXFT9UC+/1isEl/NzxNTSXz2kOkvLpM
-GAD48xSID4mFygc3KvKO1Hyv/D3NO4hZGtBGDKgT2N4rnP5VsFIIF06jVXnMFw26Tf39Rn9/KYe/UHmf
-XBwGpebOmFSF3qR5hNBPTM6U7ypa/ITVNR0ftf2iaoCMtT0GwFvH6WQ8b0mmxS52nERBNUm0xFSuz9og
-BCPim5PWtxTFXGedFJzBGSEmWdKcHvhG211Qt/ughvk0uC+WRGivOnLLN1REmtSifSBHxtg+wFLwRZPR
-CR46yihU/jkdsMK7OVp7BNKk7KuMmxWXzhWm/OyP/KJ8ULhgKM0nLzl1zqFAX30vXH/of3USYZVR+wzR
-a5P4KqMxyinwwsqMYGQGm3lxgMkPWvCK25spuq9cjcYSNQ6AP8Uf4fGBjMl/FgSqFWHDjsH8lXZvkOnE
-kg/E6WHKOBcU5lKxXoqXoMD3IIjRRgIpXM4fdEkR+/TGF8WuEPhYfh/htlzi+suDBwaOYG3m7TzyXNLp
-NJlgUquiuGGDdE32mPRiMvJiKsXrf3t20H0wQing4YquNw6TAux8c3Ky4TynAFazQkEhfrnkGOJM5aYM
-AiSDHnORNrgmhjovlIdxhPyjfFB6SVVYlLY/rnBSeEuOEMxNLw/yDQk36q4qEz7vwMslj1/EZtAiC6pa
-0PHKgjVUfSpVRwBm6QfD8UeOJb2kVyvpTiIKfOmsQWVSCUBoa1c3FnFuJjV9HG9LCAODPpy45KHNt2we
-LSO2mIQDcb8MIBIjUGDUFDgatEVT2+9uALfXFpXyrF2SsXm6SqLf2QIv1cxYjtfdYqgp40jkWUfepVnT
-dC+8pFR7aeECJ8jEZXjPd3gwCHShHM/pcF74jm6tRS4qLvFBJYJSQz51vXdSKcbz8hi/5pIImKbo00Bn
-AEng1JWrN+r3XSaOH5HPwxupSAgGoKPjAQNu8NiaCXLbptuOIk2E0glPWnyojwMP/47/GdFPnqe+EZg4
-wB0mEbGiOJ/+kLbPWaDbu2M2xge6hyzpBSGGXko//mPe9VfUcyVk9h90jZMKdhsjvrIZ3RhAjZSSKAFb
-gftfOB/Ig1vNhep/PfS4uvomZ7tF6seFR4zD/2+vJGs8P4TmBv7NWExbcv4t5ji6XUSZb5cs1hbn7DCk
-37YM7w+YkEM+zthllO5yMfxK3X+1FQITGB69JGPUv6WNWJNxO6UbqUazmmHqsenjWeCw6VczWPbTr/EA
-iBbFKgrZ/wrIRJs+QrqjKjauBvjSoygYRbzeV7BC+C7vUSgqXMGzk2LNO4BXsqXHeOcj9LDfywVMYWUI
-8lezoEcwTxBk/PoNZt53/UcPHRu3Ynljj+k4y2Ihf7lY92te99sZgP+XRgEf/wA7qfV4kNvZDQMekyxj
-o7CAATuSyP57QDgQ+xDYxoS4kcObR9A7dFMD1Anwl5+dnPyNF8cEvmAEb+AbHXymX5m6mgHzUcmMHP3E
-7cvvFMtOJ4DhH4VEzLOIfWTaE326HtOhqaWgHtzvv9uZYfYycyN+0gj1A+o1B8rfL9IdoOMRCk24Xk8Z
-Ul+Zs5eSy6u8Xr+qIAsoxreocV8dEfoahJDpUCmfAAM7m5ceBO3Hfm/03pg8N8KRabu0vvCulNpKrYRo
-qsfS5x0IyU2J78eV4PNoggYYosovJk7UQx5u8weTCHVMX76foCsXfv4qfoLqjlFhkSItELH2w/Kl/uIM
-9Dz7gf6OU1BfXcvMu/qXKILBcb2ImEO9lb0O3H4flZQpT26PqLGe3bf9DizqDubQrvMUHhyqNr4DYiGk
-cpWkjWmRMPhCf97/CiOqbRFSQpBIfKJAywRODKcCOh3dFtCIGiGJxU1velBckEK/4cTWJJptYPKbqlFx
-E+RoFplXBJjInfjkpEMZIzZ8X12qCCv3dqsU/W2wna5mtNE+2bYvrxsKJdzWtdPOaLwOVgEGgVJAIZB9
-B2z2ykgOannjNn8wDScbTZj7G7qchb6Denhycknbw9ML4LgO/hGniOeg6FLEwSLY8Ssm35+c7DAtxLLy
-4NHM2wYLVNbL6IzpYqZG2+vByy38H0YNPSyDRTCEtbbmWVZdob1sNW9ir4cKLplstwhFMH0P07acjfmp
-E6VzXKKvLnAKDnohQHdRQUfAOIguQjuqnD+4D0yfOTkCaALJ2XKAthpAOIQliCs+qupBmGU/iLwld3Ag
-hS//E0h9eYrZ8Q8Hg3jT3bjpYEvKUE6TlaIfjusb8KBmCWjCGvNX8T0vUKN5A1yFVu5bAH2SgWrmSxl2
-NsIT3VPmMQ+4WDHz9L5qgZ1OPfxgom8XqjMJuF2Y8fsDjZuEUfBSGne4V+jSWaKgwzcM6cnBNckvbHNI
-8fN+hioYR5B/m6SFH5vcoLhjif9kQdyMBajipDqQanwPDYZf2oEJgYGX1TbAcxgUHryf5jOuEuQ4HExg
-HKRudTAYzatd9knZQ/D6a34JB+oOnYQTK24sAPrrIzNsGev2faGZsNCAtGDv3YpT6CYDhlmQjxytF6Az
-tNGVbGEoW7CTOExWLR38VahjJILbCJXqE5l67A7Np5EMMxkvUot29deTYkAt1eNgrjexjy8QgPo7/rxM
-zF6znT0MFmB89ZY3dTEUk9L8q7sM60EMbukyxGT9YVZJs6IHpIl0YpROSHzH9beu7DtxiTriJxKjBdhC
-aWpM24JOL3hNUc5t75eDkA6eqhMFTmeJXb6k0Oh9+d1BDa7TcWTeKTZYZ2y53/8LHoQXFIdB+T3IJW9W
-PaXDng4MHjz58+7CYImJ/Q6jnnzPOAw8+trB7E08HhxMkK04ky9fyb0bmUWnDTY9pEb/pRogdHjlbzEI
-hifTK03eyx6nQ/pykGf2/7L3+8cVfzu3zZlBnyhPtnMoTZkPlJwZEHx0yh3vN2WZMQWMUNdLiPCgF+LY
-iCtTaR6r8m9OpRbxolKhlY+Kg0dxw83z//Wm2vqEHrCFsn00CEn1R69QjYeQUusSc6mrE7yyyvyzNlxt
-hXfguDzrz7pwTI7GadEfYRn2W71Eab5M8bQoZtulJKtojjdb44yGn4wbF3SKNHjksvoOL8MN7cWirf7o
-PvXjxmDKcGQFKW+o36fbgmQ7WaWd1f3bKc56vczcDEUsSDIHmyXQiP43lVriNgsXUQqWD2c3F+k1fgcz
-neFfvLXtKs0W+D3ahCt8eHBLLQ0zaWJGtLK5fHexwfQtQy9joFE1yy94eRmhtcJQxMNKz1skAx7yEuKK
-eka5A1ZF2camqB8dUsHJwSdpuuOtDuq4zGToz5UXdAyaDe6tzMBKSAfKxSW1HPeW0iI4WfBPHlaMBz1h
-ZdBZ1iAXzWCmUmmg7vc5EAOfEjzVi+GceOAImvi7akI4W0TwpLwqVl1YTolXqFFNgbNo77vsVJnEfAJC
-SngksOd2oLv/BrVS9bjf7+An3rKRBfjN4dfV3glF6IkNDT9r650fVeT2jMKxDGr380np5XL9TzBZsbpI
-XotKvSmqZ6C0aHXbrp6Cynp06T13v5dBGqqpy6K6agqQrXjxCCad1VyiaKSAovus3H0quFiaVEzuqPSN
-0708wsYrNGGU6omFKdi2arXLU738MFvwHmmyRzfI583GDa2D0BXtqE3Zg2YCHgOMrjOpGZWegwZxphmW
-W77rFZN16Qb8ToRYd3/HXKJLa5U3EEy3sGzI2Srg2+8j/gBLl8CWc3NRCQYu7bEKvpQ7qmZz4LYDJZ3B
-7Iq8oEHy++iZLHu8LhpeHLrtGWh5SFlqBZ3txCWxfM9gHJ/l4xyIjQ6TcxuHclc6KTWEv0O5LYKZt8XF
-W3lpY4UlDFcaDJothNmH0BJDfhJcoUuWIiM7EX8W4TMs71ZCVMXpi4onKCDXBvyzDuQd1egY2u9fFw4e
-x3xoe3kZrQDj8XMy6zYBHsHopHi2c+UDqlbeEqxibN67CZJJhPcITpi/xkSdk+nMD/0NbU2CXu7gwQ8q
-CfO1C6DyjbeAH84Ob5GhF/NgV51BvK3G2QKZzQmjN9MFfEPDcSO+bV0Kl095UlLcIrzlG0M7HN08uDG2
-d8Pb2/E52MAvaGgc8aszb4i5AziHO6oDVUdyczz1tmDC4nMw+PCMPEb/d5wQ/yCMfCXc0Khxx3xyI7fD
-1p7sxPVvYD4nAowQsBW7voz9h5/on1Uk8r7QNsgo8bKWoCEdyO2fKbndkTMjAYfoJlGvUNIBReMmPwj0
-4NIcNcqVRbpWHHiRudBLlaiD7F1RGqismrRAadR41AOPVnXwCiUgGbzbwy3pbS6K+1vxxT3wy+zGkZbL
-rzrKWIzShV4BSOAbS8wgwH1ft6I8F3Zaaf3KVHoqhBfeWn0x4+ZHFvR6MfTML/qmnKpav5nst+LWgoUY
-nwEdcDDoKwos5QSO+yN1s7iQnzAbtN0T9x/xJiewCn3bPmhp++QRDcDJWXxy8r5sEm98BeI5y/hT5VdW
-T0leAv2oG/kFpARhSVbPKqcQhtqFiGd6rhH4oe34o/qEbGXB64mD17iMLqGJi8Ae2t41+o/hyZVgmwvv
-WbDD89b7fYh5a2V0KIZdeouTk7hyQibGM9nve+KQ/zOg2Epiw/1+wHXuK1qVMQ8ToNta3bFI+rgOXuOU
-ji9gEulQFwX4rtTZtg3gfoVb2ejChlUJQ3LdW55j3Fm7wv+KHbwPPkHLvR4iEV2e66CzwcZOTi77fS/H
-PE6iEvGmy15wgXc6XABUl9UeC97jxrnGxQ4dChmPtc6GIt7qApjONUnkGy6X8U/wG19ywFvGnKu4B8kq
-tsAqvCt0x8O0qwkD6NSNDkiPlXgBaEcShBzhLniGCR3LfGogU3LXzw9xEPLwqEi/BbgZYEsJal5oGnUH
-b1qgzTA6j4V6blHlrwmekgmAgPFwEJhY5ImVUtOP5LcxtAsdPkNph8xBhduWWv7rmk6HwQNF5Yy8XNDc
-JQjr2Eu0zXjV0KfaWUaUpVKI0jBoZBF3aS0lJ7+lo4RL1JaW2r4nKMRyBh6BcvnqOS55IFjMnuoKI1oF
-rvODE/pRnzUuFMV7ctqwJt5D4XlBGeju7CQj020CsWuOEUUgyzEVSqe8b3Fc8oxcavhcY5ZjOoTBfw/0
-Q8fyOBvYR3nTg31Lal8OpoOnAY0SXueXqEZuAw64kKpRsD0KvzhGl0vB1jxOJw4O5lLEhnizJgsiFeV5
-U9ABTsNRRUxXI7daJF2hE37pAq0VXmcNlKDO8eHBOz1aJriQZ8BsV8bKqPueYC6BDTQiboJ3Ht63Vw1A
-bDkgMToS02g+CWeIfjaeBgktdFYG3S8xkDs8sz37S+7Y0c59VD06WB4NzP0es/GRf4n8nWsWrdbF/ipa
-FGvbM8t/uuO5Hkfl2XLns+ZgBmb/qH5spxkubBoV+bBO6+dXquHaROI8ZNu+Y8i8qBqzqNk2RNCiuQ+t
-0+5DIzTIg1MUKtQ2XTK7TRWiMsJbAPV9AxwyjMYa3p2oNVot0qLVIhGtxnjetM5wUpsWimOjpES4dkEm
-UCbibVYJYhLPpqDI8KzG20x5aa6F/Akqkgge83zzKiKMUk1hksd0ro7FwTMVeKgFIR5kYODPlJNJ8vCX
-pavgZ9yPLC/+F4l3GGc3zntijV51u44OqqJnyisO14NnIHUvwvmnvBKoxgJDFryfaV8SO/evtWt1BYiV
-tDXcn9RhdGU66UnzSnYnrocCmGyTZjfA8DDfKSpkoD4NyTJNg7hM1dMZjkE9TM/CcchV1hhdOyLlOjL+
-QtrtI4rwK9Ltz8lLzJqJ6THBOOYckPxQmE57N9kp3jkH5izkg+uDVgCg+1u5G0GXaGyD23BRSWOOEMgZ
-kGCOFZlbEQ5RJqGvZUrn4lvkHgIJXcmVnE2YIJ2Tky2/jxJmMJY6rk/bQRLwMnEAZcFDHRhzZ+rXyk9K
-LPrcY1d4c0dTMXiSe34w2JCnPUbdj4ZRJoozxHDLbTEcl8iJCIMlFR7MJzeWcitDuUUJ6s4Q5BS0y5C+
-oXw9yJT7uAFqYoWTsnH0oWGQS8fpxGC+x0qk13dcypHI/IK8DzG9xoK7IFNXSTa3rJTNFx+8ONVvOlBN
-7KA6sEuNiERrWN7Y1o52CygptzFhKY4SHSM70jYpnaVX4AZ+wVWcSaEuvC5A75vs5J2//hy3b0X32IUB
-3O1A9l2/vkCrZ4K6Ex0U49nqidtlujbDjs90aosM5CCj8KoFsKRKHoSHXefM4lwBJZgouwDDHitSbgTP
-XoZRfFe9j7QPR/WStIiWN3glWJauMMq4VldWm2HogI1HoShgKAtuKXmbAWXJwQvjq/AmN7yL+A0SJRYH
-CK7TwGqxZpWrsnhGAlWs5Ogq+V15SNvMXVKh0QcpssQ8qKZ9nBaojTI6RDHFpCAzp9E76CG5+SaLsUjx
-X7YnE765E1a/PUNlmRdjx984H+5AzoCDBw5xVoCnTcOejdRnz6hTYoNlOjl+gYyXUw7WEh5kdaACc5ld
-FsYgXfp6JEn8RBEqQ58MhpVEmggFgR5tWZANcIK8Jp6ZjudHiGfMLDLOOE4DSiuIRjN90VGcBDjr09Gv
-bAb1JHOAJ4/oNzIH18OpGc5MV7TxN3VURZPMN6/aanmAS65yUj0lxiK6ZVHc2xFRyvCDd1Uhzcq2RCPt
-p1dJKjviOYaOEEvmo2wf8UNHfiW1Y9iWSlOP0yxQ4eE3E+K3+sUcZ6NJA0Q/o5iOfJIKouO8Do/Q9PsR
-dyGWlyLg88OBb6FRkomzEaXtzAN5JwaoN+rrTn0dZ2J7Bi9prY0fH5Uo0H/JJRNCvzsMGuYrJm2umJBE
-au4S0Oq8UB16bAN3PtWCII1WJkVoxKCAzpZW0wwCoRhTkAAm6qZGmTMAbB3gugvdWLGsJ3GUfDo9e0Km
-JJhh4q80zU7D7lmIxhk3a+h0cNCV+7BdNHOSYNGeN4CEYNZaIrS5RyDjp+EwBymdsheagtR/xnlzwFzb
-xwtFg7xyNrZRUgSUgIRNWwERx3ApUyuHYzDPczoaYoOe6o+21+MlXkDhYxLOcboN5xGoMIOvbZ5n6J2G
-c6iB6p6Ws8BDJ1CIAuuXdQSGJNRmAQaLLHS7T7pcoHSBN4kEnTZo6bU6hwvl18UmfseyKIyj31nQaa2I
-k63Xo5EGpzDCUxFvX7P1qACirqDAotdptqE+FoF9GpIybLTQobhAUXD663DwtWqdo1a8w2KAZbrYA4DW
-EE+P8C0S2s+YXjrlliE1XLyTATw7FcsDLxgsGyTPTqdBAsAaNjBuUUIg7OtnMSxqsNj9JLwE0qc/OHON
-6vACas+xONmtnaE7SGG4tIo8jMQDxLLvUTi8RlfVj+ENvEY7BlC8Bv3/0y9ZuKX3OX+6ja5ZLN0o/BHP
-qvCCX6dDF3DiZerYJ6UY4U8yFtMdrj8B44ySt+j14C9gNb6LfgcKeytK4ONUhlVVmnsmnqW1EYmyXl5G
-eVE1QPhz9WCnXlK+I5EKYkHzK07xrYH/10czOqRNtIpFxyMnzN4RxC0UCshLkppdI56gDVx3jbbEOXoK
-IaH26Ns/ZHlqlVNWvaY8y8/ZZv1tUnLUWI1M+sfUFR8YY6HzptTlF7fSAzULAu+C3DlVxvXJqfxS4eSq
-8qKaqMZZ1NPTzMH0+FTJS1Onr9EBpUO9W6jliOCNZTV6hudLplgcDEWMEoqkqcmfOQbO270lR1UxXfbs
-73cXQD85qDtzyrKLp9pLJ9t0juEgnGzQVTBeCLaAdsAqw5tin8XRNrDnPBK2D5RvN+A2V0FmPQdOnNGw
-3xHzq7RDLNlUV43funbq0eTqqhzccsJNIO+6rrQLEQ52C6ibsEj94XhDKxi+XKQZBvUNx7CwMOGtf4F8
-Ah5f93Na0b4G4bi/SX/vt73jWRLaXttEqWbBwAUKiD904ThJi37hJXXZqIAn1yv85Z5Y+LIVzM0PL0Dx
-gZkdoxwdjlF++v3v4AMilSOhLySsXVstiVv5uagpL1JnKTL4b4G6y4K+FPzbKT4X+ox9RPQXmKoipf2S
-6tjuM1cJGcfbAOOQqY10uQT6//+Ye9f1to1kbfR/rkLCeBTAbFKk7GQS0DCXY+c0kzhO7EySoRgviAQl
-2BRAA6AlR+S+9l1vVXejAUJK1vr2fp5vMhaBRp+PVdVVb33DveDmqhNgDuZs2NcMlXzq/f0bxv34gTMr
-o7UY4uwX0OgPrIK94bEzQareOYfcT/tTyPlqegTUkO4KPDYnrsyEh63R/fstE4I+gNEH2r2/0i4YVroZ
-f+T55eQGfwFLADNTd/OyJ170kJetdMwvKB1cJ0b7aX5Jh0ey4JUOZ56tc9cb/R0L1t+P7C+4LgG7lwfg
-V+cR61Er+TLtzgykQzgu5cVvTBLfSbTKRcoeMdoe4dLGuHQoAhPGZfFF+KIZwovtFmrCcbbW1a7C7Rin
-UNCIIoNeOMMHvDQ+je6cp2XPk07CbHInmZlZQmCNZSp4t1NcD9ozwRZlVxpPUq+1kzAOmYYda9DkjV77
-RHptj6J7cNgu9bYKUle4kzsaQWuqAfkIPyQRUQWR+BaAACWLykgEnnkkABEf7XzqfRbof8E+tk9v4Nf7
-5ez+6W57OjXPM2Aev6AI0yf9/8DndX058ZNx6MFicvHDQWReXMHE3MHPlesTHMashWZZlE20mlwL3GaY
-qDm9Qf8sxJ+jIzbIm0Nvb84aYtDqpFN9QbnTvGGvknf58Jpvt77JEe7WYXNT+ycJS2L5KDuKhB+Kd0N7
-Q5WzG7HrATxfQXlwz+Ff1unwL+O73wnnZCVOeIPcwda6+YmD2HQ4Zh0xBf2zmEPtA2swxpG80DLWTkzi
-KTpNu8yisYZ7mX1XRtmEbZbgHIYv4HJ2f7KXNghCClW5o7j4i+P1rnNQ+fbHHcg4yp2BLOktmdoxn4X2
-kdGFoWLIt/6sC5hNEBDijzQTAk/jd7QKJhVD6LC2kXZJq5wWUO3Z4V9B8abVLPThfLhun8AwSwR8rhw/
-E4GrVlErXWpepJhW03TGuh/Z5PBbYBKxUw3HAaFvVTJ3wC8xgHa2JepbaSsrUHL/wEEGdyRAbojADGs/
-iQ1OZ7uND2nUxbflxMmXu0kv6d2ulsJLz9/ANQzyDxlHm5XSVXJ5lrBau0zl0JuvynQRPjv5x9NnX3z6
-Zf/Jl58+649G82X/80+/+Kz/8OHDTz558MnDIf3P4wsazrBTu84BhdKDP3WHHQu5flOHh7B5/ZZNUBbN
-PBsSwJ/0u7mretaOa2P+ojFYXv+F7BT7ZX39l7KUuPW833Pk6kLaHjYhbj8/7DbWaRvBQGoogzW97Ybd
-WOEfwk5Pu3ntNIF3YBWV4/3Yv2n3i+tUSg6BOMIVrPZ0XiMu3WjvG/YCknaOa57UUD6BRLd02kwL47V8
-U+JncIEalvDgqG9/S4c1G1vTVH3FGxW44QUrrJrG8x4y7XuiWOMua2Pg/gkt4ntUKjWHAWjHt1SEB9Rq
-WmkroT1fruICne8AHBJRN1scjMPYs0MM/ecJxay1nKC2iTJdCedyoovQPddb7sH3CqgT1fXbud5w7hlV
-Cvbwx6dHE5PZaJ/rru7VDjpfKK8Phc2WtRZn1JqFacfpU2jHQ5FXFRsxQGCPOuLfUl5HoYfGyxuDefSK
-nievvSL8woB30O5iHZb6fI1jHWLudFdKM0VT2XWSWR9q33a6iYUOF7fdE3pib4/nuzQ2GGPagKN1KOLX
-W/G7TbJJwjtVWRKYZPtMSlzD3Qcn8RTm+GvTmgBql/5hKh5+zcXDpBEHHl6NM0QcRkbLkBVnWVEOIJqL
-ZK9KcNnJ1BOVLwodlC3HErPuxj1PZo1msAe85mjf5Pnb0piAN+d8UuezG0M4Zy5iIyHi3QyBLA4rAB8V
-kemZWfw0Ny3x7AYtFgofynhQI+ISJgOH0DCAgiGrBPDtF+4Hnbp2+4rUXc8xvPoWth4E9H7d3TeicXD7
-ZXT7GpASO0uUSjZjjX26+Uk0OvZ28P3ZZNRKToy1455PCRa9wB0gelX6uHjc3rUmZsD13k97R8jQ6byZ
-hZ0bjpxhbkLxbtmcFXonUnpMqTruSGL9UHE4/sxUqbeujrn6Z1tgZyarPeeglmah3r2e4A/0xYijYj0n
-EK12QfCdqmljp0aP4yoNZgPjjCeluw5YPGjiFBjWneKwH+9snl46uh60frvuuF3nliNek417VblCd/1q
-Ec/lVK3jGjRWDMowvmMqVWy7r3eMGhWhsNsRCFOVNBYUb2GFrEks/F5P6TdeJ6vafGjluxeZGZ9haOIf
-6lf1c3Q8Pa1Oi9PsdDk7Plf/jo5PC/r97c+d8GzliGdfPP+U6PEW3znkawlpuxDjbz9GNWXeupdT/3K+
-cenjxooFodM9+ayfSh6iayaJFMjNfYrCEr9Pmrn9NYIAidwFQR27/mtVQsw/q9KLZm6NtYX0X6XXsqhU
-ZyVBFfCeg7t9s63rgNrXBa8YmiWMCL2vn1C7EbX0az3Z99UZiW4eMy/b8I0a3N6X0OnjPuEtnSvha8UJ
-7inRGauvZqGsPGZNQaYtIo2I0VDNHJe1TqP4vYN+eRG1MbkBimvyFdAnJ0DgnwyN9jNbyobMzd7k1jgj
-hbNOGGcMHzsgZxY9Cmx3L5LnsZO38dhedKoM/m9GAuR8eybVMpv/b8bHqd3/zUN0ywhpDNmOUXocDQNg
-y1r3cOaL2hs3sN965GB01Ri8Kj8/X+0NnkMD6SEw1MSZuPFseLOsByzKJkIgOMtCtLaa4wDJRtMPcccg
-ZvUgOrVsDGLWGkTFNiC7oJs+aeBmRpmZoQWrGOui2BCsMebGsDgWz2A5dHGlJlUwyRvtqoIwr1teCb/B
-HmdSIFTYrmM/LX6z6qB4Xtccoff6tf30+rXXnqyt96j5Spsr32rCSfpdudbQeKZNHapeDDvJE0sbzbU9
-cjoeydnoGssim7nLAVmY4DvXgZ3nlcxvzT45sNvv41UXIiRLLIyYgk3gWtuK2SzSlipk0HUK6amRj017
-3Mbk0FZrzEEzS6luUBxPjEB1kkc0AHv+XykckIFhzbjlgZagQH6Zd+EkOl7v4fQeUPagrahEpqWmXEk2
-PgJL0gy+RXoE6ssjUtVL2fq6wI26tChXRulBmF6ZqaL9kJt90hr6N+qRd1Qiv7MG57YGDMBAr35dPldg
-koVs4SoKFB1y7Foo8W+oc4TSWRk6C7Qi80yG/DK1Cm9EYSu8aQAyJTUXw/ZmjACZ2PqMG+qjFdu4gKjc
-GdSgW7LDKRhBIwmhJRs0N+GCaKZIQB9XtA6uzfBxytJzFn+wpX4+SXsji9+GU/RxOilDCg41uEvpWhKL
-u3P/MLMlHh3BiBTXCTWh6mjfUHdaVXdpZ5TdaptDO4jrI8amFPGljLrvxlBQlIPGw9rTpmzU17iD58Wj
-8lobLzaK6xasG51cdQPmcZea7pWVZAQfLINI9434iijF6Q11MaPoVVsVXNNZaauErYin5+GwngHUfa1B
-jPqjAPckO9Wm8a2IR267mkjPtKfgaq88OvpMfk4OHb+HnXCnrDEr1LQxJ+ettxRdUzEoosnHoCZtTDP0
-DuMq8+LMZjwP2HqJj70BjintRiWY/Br+wVsNlmFer1Z61FPDj0VhivnOYBIDMby5dnDXyAsypuUSB2ZO
-FZxfafPjjErOqEBWvPIpu6Sp6UON7UFlC01u8DNcEBx+dnNGzbnCDnJgW2lOet7R9oSfLe84hcPBoN8y
-dXvH/evo6Mft9vBrGwBzejqS+c6jllR72kIOotVgFplI17bztMOVtrPDH2lTLAKZaXo/Y4iam/0VIu7e
-zUKvFdOo90VfTQRrznqlRaqV5mp4ugbATNKp/laJQY+OG2E4drudsJfUZeENFCTpPIRW5Ff0pDRAb+ho
-D+8zo64Xuv/x6olZC73Uclu7LoCO6md7o5nrELMy9I1qe6KmHRM1FWPCIuxeJGljkUhkaaoZPA2j9xeP
-I4rOlFJ9IlUTFoF/m0HmNBoG4W974Gvb7T/3wnBjBGXayTBkP7c7uNbem0Su4yYhLPeWXrg/4ZuT5PBH
-Bg9odHgW/NlyYPNEle12xvpif8WJM0i9ko9Pr3rH54HqkojqpK5TBSZUbM+Ou2JEew1rTE6LhtyVFgcP
-zFI7861o5hQ6j04DVMeQcz81rYXd3jJxTZDv6Nduc1caemmq75wPei23Z4RbWufGMfGbxr9RBq8qfxwd
-/aGXDl/HUJEo74/u/A3u0Z5Bb43uBlScxjTj70wLNDwNi9pTPRn5BsTsU4LfLRSeoPUy7GeT5ilojMJK
-5mFzNODKbz8wE4FJO3ie58Wi4VDkf2zJ7HnsHpLra02Z5ZKwJroHIuGMWttJvRg6cx/XYEyFYy9d6EIq
-IcB4CHfKnSVaATRZpAIW0LmDmKH3BIycjjltX6OX9tTTlvWeqHd6bWvlJt2yV4idkvqKcJ908OJNlQvx
-gKMpcOxhWoYH2Bd0pdjWgNiOYt6sENv11sdFNWt39m1uDit4bGgY4xiblMbKE6uJ2/NsKLdtt1UXddwd
-GRdbXrMCjrkDV8O2qyaObzmZkg6vXhVrQTY5nDucETciCrWomTYZAXM4QsM+iRc/ACJdeZfxtTgQASGR
-rFYvYe4BY01+eyH6fUiSX9GnDOH5Sj9tyuT7eA3z0YLW6ResZMoReBp/qaexO95msHF4CbPdYGVZStDo
-UW0FYnuTElrbEY8ecq6e00ohxpRnzJ7apTeY+v3ZnzkbspEr8ClsjDNc5iYxzE0G5kbva6bm2hyGa94s
-FPM4ulU6sQfhoJnmCXTzQ00W4haT727+c8f9DN+xUFHHv79NPhyrqpK4lzmN23YuCC20r2+CLVsOHKtM
-x9AmAvyz5b/5pjpbbQroKBYcafr7YHY/gDbjwB/0gi19qfUh0sp1omGDcyfYcRIdV/q+wlCbTTxlfVuR
-4LaCRpnNH27OV/lZvIIqVgNHQPQk6VCXZdUww1MadEq9ry/3mWF5D1eCF3ywQHwyjwri1OYmhIiPuUWr
-YKexmxSLWx4io+kYKH8VvZfqAbTQeROAxCW9S5YBQ+qbt66JoLnVa5F4skyTs4L4+fw8KRgNWUQbdMiZ
-j9CaZXpOzI2XA6r0pWPFulMSFCVQU2XFiKZg3vMAMZHtO6ovI6xEwH5mcFTPdoLn0YcI+ELqMvLL6Yl2
-v6P1/gYG3SZQ59Sl68jUkU/DeMV+BWjwzonxnaxZK++cKAtwI+F6AKKcGcft9lzdmnZRK3oK03aucuoe
-zuODqL7lSg9iWCgMUyiDpsxwhrFy0YrCmHu6Joz3oYxi6jra6tgoMDQuIKm1OzWnMb6IUDuMrzwxfKJt
-3dN8k8Hkd43zdAP3CvJgUfxpdi7BBx2OWCpCM5uNfb5LS2plAiSLdhCDK8LTSNK0KEraBkVej2IGdFKv
-kQeGBL+mZDbW0F1l5nczIJIfqNFOLiwuYbNpvZ4aUlbhhUicFrwp88jJakWHQPSjbcz3QTL2QTVVxwqm
-Ydfqkj4Yr+ZavuQFfGnWIRRwGK2ya6JX+xOdMbnMXK/sXF9E5zLXL+6e6ws4MmlP14WZrn5x51RfqGU0
-59gsnyzZZZXjE+j0dBB4vQs95+iNNt/BffrdBtBu8vEEL0FqFeUWZky3Lae2xdFyCv3Q9OjonO0mzWoh
-Qh0KQRhfDpcJUAIdTk/6gZ3zUAknqpbjmVWEm4n79z2xUz+sw3mfM3MlB1CJm2bZnDz9Pk1NmRBYGPJk
-1Y+CAKZOh8taUXJNVYuLBfEniG6eTYILdWk2W72eDKctK4KmfB3D3I6j84PaE9sCqmvzwAynJEfKHiYG
-T1TM5rYm2xwyTp2jKWJPDcmT+emxDyu9qdfrwIK6dpxh0ZROhBjb73tprEHFYuYXkyqjJVJ/syPHEeyb
-M3nDKWukbqJllOK4idUD5ozqu5rPWu+Hmd4Nz3t7BxNaf17fsw74hpXCLqNzp0yq/qVVT7vUyydgIbyT
-OMQlut68sihztJyJiQRo9/VAhvNc7VsQ0JSGCVxavpKqRfnkJHygnD6InP3bDaehipzXyd4avPzTNRiK
-g1Co/xDrHlXwJsk+KyBK1o9RyhqATPMV7BW+oWeoWHJ26+kH16xr0+1AxtGP+vAH6iHPfLkcABzvIfzx
-iDkn6xKn5S+s+O6nWn94HjW3J5y+ZqTnvXMs5020cb10jDfjVoje+zeAdt2M4bXHT9s+OmMaVR1vaaUb
-aXIFVxmSlVQMUL27hb3Mp8IvpgsIkDEDqfrQkInP2cPHyypfr6GZFcgiiBaPR5O5s7+iLWXkm8NiU69A
-to2aSrJZfZxQDFm7NDccDJcN9StltIK9zGomSJ6O5cYmsFGbaTSQFkBYuOBn0mzR9NV1Plc8TGia/vxC
-IqNh0FJdU93kAwbcPOtStHMmU1bQqllKAVTrlOZPx+hHKVoDD014EMsHtU90nrMZOfLwA02Wf2Dl4HbE
-qs5raS+ezHLYMQgUk6phEyHNZLRMr/19DDRQU6sOuJRNPa5yO98c2sTcpTJkWns52a83O1ZbEV9CSb0Q
-ZK1yxodzDN8zXffttvHqXGXrQye4KW1xmpYq3VhqAzrcTu+cjvxMT+/ktul9Q2fipsAa0RXLNZVf5wMh
-uSluGjsZfnt5mSxSOAjtytk/TBpbIESC7rvsA6lDCrCTJl0UHX5Rim6DOVUqdjlF5Pvt7k4t1TETo0RJ
-Dmd6us6Bns+6YavaHisx26ldToyC01xPSrDqnCb6QQ3ORiOWl5UZsqOj5ntjCFVST1fTnbcZedSzM2tS
-NTQ3E73d8+wCoLijA0FdrlUot56Y/B9abyesPzXeHPLUa22z2y1rSmhNkUaWNeTDoWDu72fMm32O+mLW
-rKx9SCZaWKkl0lhbJcddmgwAP6YNrmhyTSeVqG3wsU07ICQhchugP2m/0BvrFzrgTKFILi4tgnFuCbtS
-e4zA4IebuufznbOPaGXJVSs6F2ZTGC9PbJbJmGvXexZGDklhHS6Mm4jpjEGSR4nRuqNsRJSTzsalUepw
-AiOKpw/NVOtjscBFbqiS1qe3yQd9dQUMqahkGVcp3/jRGOXpV62Sxc8A63KIIMBVREWT7ieyOoDuQjVT
-ct1Bf+rbR0uSmEfcyBVzLXfBQa3ESZJ8daeZTWE/OkSAAgplFf8r+RDBJZR+VqUGV5+YBwb+ChO5wStD
-L15VFO/gTCAnDuZxNk9WmMsH86pY4VNj7zvglf+CmEGYBnAZB4winCx0BKYwESx1PKjSy+RlFV+uD94T
-vQFfF/MLz7FVVGYcIViqh0ZXb35BEwN/nlIjD+gz/uG5lQV7pO0UIBuIVi6Ye5GfjJuIgcl8Uj+G1UCX
-Ame+O+XMJVMv2UIO5Ie6bZVSt/yqf387WBb5pR7SAzF7/lX//nZAe2TyK//97aCcF0mS/ap/fzuocp3q
-T5rX2AhL2gGlIrwZOmWPW33ARZurXahZcq35XtjOqTbhSOswtY7pdb68YwERQ+k868x6PlurUIPy1eq7
-ZFkJ89oIGAZ9iSVpnFhuALs2526yuf/WyP1Vvm5kzu+tvOs4zvsQdjXJoDFvmVLzW4HRylmKfPWoOyBc
-oXI8kYhrj0TVzMys0VEJcGT6+yB8SH9PwqHMI30ghzerPF7A+aNwBuyyW7zd3ezxpdZeEex/BZpUNj9E
-DxwBbh1K5/HhyBXgqob8w9OCZm+nIF3uLNLJM2oUixQT3z5yUdoSa6+IfFN5MA2hk/CuQpw7WKEk9TUs
-caH2OsGoMgoUu1YYpXwnfv1c18UQ6V13T+6Nrx5XBZA16qWzhM7oZJPJ4Lg0SpNi1iSKJZBAXKVZvPpS
-iyxQjlwcm6i4MlNlerlZNXA4tejN3BZbyapzwMAyiwUMiUrLlzoHMXl2Sw1hVDAuJi2mgDhSgUMI9uXV
-2jMIkBJv4X32+Sa+anFEOlHsvlmRafvyNOmKBXlpRzD7A6Jx3O1dwcrdL0smqjF4BRfMyaiaCd2UsifT
-wvBUjcg+K76gIdKEzmsozKqDNCsrnIa4FJDIE59BJvkKQGZeYxQibRbCjKUmYjhgv38jq2Jgg0D7u5NH
-RGh8L/WiMQ7aUvpFa3AmaRXmlaZVpArQabKzSqhCrTNsD+VIWmReIbPLiEGVaA6lxkBnQUMAxIZ9ph8d
-1283+82liqku5krC7+CREKE5C/exZvcHYnxbr6eVSjo4mMk+SxM2BwMzUrV4nP9JTfZbVtella1B/m5w
-UwpiWVBmcmJEbMWPWF1951ZMl39HF6MiHGuv0Fq94YYpIPRhEXr8TOu28IQwWiXx+8QE857f1jRocf+z
-6KZxXFTKSIzoUViJLj15kQekUeuMZrQOyxBrioeti1OBFD+sQel94/NLVieR3oYzVlnNwXdDByudBqqC
-bRUIRnzTqG2ik+q2WH/na+/N+q8cgYLGCDUTmxfMCuUjn3aD15IpyOE1rE9NgKf2us5SMKx3X6s226NW
-Vn0drP2RQniNmoRERzpICIXyGg1mqyu3mjZCd50SE/z6zExm7s1bcofAn1HVYe13+6HczLO+Dmh/kbnu
-6nOwnEYLrOtbX3Nc+55pQyuhSqRmytyD/C8HVt9xyHfbY9zexiQTMMHbJ5l8v32S/UeY4IZZRTDx/W4q
-a7t1NGxNYHugpc5r7WJdKjB4Lb/NMbdYkUxPNykmk14siaSCr99sykrntODdrhaN7q2ErgL3c2kPdGdB
-o7qYegKY/LUEkWsjxGZHbwgVycoUlLi7ckb7SAupzMi4qq12tVXKa4z83mqzEbrLOmxNW5AUDikpr6/M
-lcbdjd+b/c663aunu25v29TrPnAILxht3F1LPTXZukNYgnoS28CJcyrcvbOH1Z8s4s6FqjuGJkLnymou
-Xs1z2dVrzlXh+ixLJhyZyz512TXCks3tyr1Bq5Td8ZvSfR6zcftArmYduwb7je/1NAB8k0ZP5Bq2u9eQ
-sN/PkLCLvjdp9+AgGp61VeM6NlYMmLYPa0Mbyx5IGiNvFayiTWd17VM3CYQ8y/yYc0/YBVbgzjy4hdN3
-gyIkga0KUD1wZxhVxjqLUT06IMlSVmKiaPpJagAsLr6ZSKO8GhvPq4epawJsKgHJcs4OTlJK1cW++gFg
-9DCUt3g42BE/x8ojpVEqKVtKU51mg/UsslsZsZxsJQAbyzzr4ltd0YOjSgJamSp5myWENn1o09yszm8W
-bG3y6ASqa799RyS94VyQTOrLjp438HrOp7D+pGp5u6ovQbhfbptoLL935xGVm1O7kmk+a04iTYFmmoO7
-DVPPzA+2UOKLlYImyJ8MjrsHJXZ09oQrrvzzzvyMoCDRNuVudt9oNa4uFWxjrmrVvvcz1LoaUNCUW8US
-OoyD6e/h306npwM1u3/vWK208qMcLOUWU4Jef86qdLV9Qsx7cKw2VXSrgpiaE1k9By4kpWfIZVGDLfGc
-Qb0MDtApU8jYmmAWuCzpsnaMGI9f8xpWtO/YX9cQIg0rftyJEA89fys6qlra7vS52OYPx6l2sMCoADVn
-gvsCHoLa37JGlXYT6UseGnvEd1wjRq1KpI9h6SJemNjnEmMT60kvkc3bpPHGXq0hcMr2fRiZLrr2hY52
-4ZTGDUHkra0vOlsvwgnGo6pbD8i/TiFeq6lLbfub8HWzEOV7IvO/mHiExGmX56bDQxO1C+RhU7tdxPCH
-NjvdOxCE5iXF6LbrE0eYtXW60leFdbYdeKDJhMdBLiYHWrc4MJa0xpEme/WXBVvMxlDRgeRyDONK5/oI
-15qjxw0giHgS6/vFjL1UtZEitPWR9gj5Uk8f4h8TWMeCn9b+vsZNn/LN/s9rqDk7XfMgzDEMbFXWZUAw
-6XDr5vjsks0Jx0XAaA3G3dbgDeVefJjA+XKoZ3CoY4tYGfjRda9M9FwuSnjdxDZCW5JvhhRWZ21NaEuj
-dVWQvzcUjvgYrP15J1QrMY6/TOh0E4oStneBs9Lbm43pNLhDQ3W+iF1vXq15j8Pd2OPrS83kvSjRha13
-e18YNHH31toV8SIHTCb8MMm15x5gpK1xsjPkrnTtX7fEGCHH+g50UmljIX1cdAv4FynbGdYZQlNYp+CD
-5Tb7wK6kLOPkg6SjLO4KD19fpmcrtonYyWlza2R8TfNN6SRAeppXdzamo4w/S3JLSX+tB9zyuAuQ21/s
-vFa5nLyUl1tGTH8FmrbLqEJVpUaaBuCTskf9nfm4zkKRyNAEf3IfpLyUbWo8Nj2RRPVFqA0SHS57JRqa
-1UobNixWqExUv4T4tC0IXbKzJ1fntL78YfzfC0b3sYvd4y4XPRJRrOh/omnHQDAw2tCUGk9Ur10Wdyrn
-XIGHaX9eMYgVR9SbB6Kt7FnDmaQDkOcFTIRMHg51ETShKTou4B1i0aEU2co68kI62X3GZoHyNEOVWG2U
-ETvNceB3O48ZYuCCCZ1o4XQWNqP4UP09L5J1w91Z7a23YSe/w65JM/cWC072tggtDqPTLSi4uXtOFnL9
-O2qEw2ybzrFBWmJjDpixcxJZn9mUvasfktql0nmqTLW//YTdQjuLNGi2io6VQw05yeUkjgttdy9fVg04
-bAdcprIQVbovE9Vlrnh4WBn170JU8DKBWKnaOMFd+Tj4alTbOvHetBYogFJPULdueqJXgtZYRW6IVV66
-q2jXGycj90g1akupRVUfUxcWZHsL9WXq8dvc2oiKacNlgYZkMBM9aH2G7wUokzpDxV77q8iLz86KbVxU
-6XyVbOMypc0x3izSfHu2SLfzOHsfl1sGV8efVVpWW1x4pqtyu0zP5zFDi+BxUyTbZZ5T92wvkniBH4Yz
-2V7GxdvtZYIPWfx+m28qWLYZYMttmXBXbMvNJcX8sMV14fY9VSP31DlxcAdvfgRddbroRR5xbzijt/QS
-eMfn6rKKjC73I/rm9S4qWvTT09Py+PHMoy2XOvIDuMDTsnes3tMTRTsUpMNiO89XW0b53l4U2/TyfCs2
-d/DShfrGWzov4svA96enV+GsF0x/fzy7H5wePz4+T9UZZ6a/HKtrvLJjsONUXeFle/S3yelVb3ysXkm5
-YTkv0nW1FZtWlBJQ3KeVA6d4ll9vWfzIhn7P6ZMWbZ+W9ynO9Pdoto3o2dguDpDDW+Rwb3sKTwRv4vfx
-NplfxoEURp+/xGdgCVOEwX2q6kvpkPuPDmHsN3367MmrJ6fTbb8fbBEwO53h+THFuEdd/IR4YI0INB0p
-75FwcgeXm1WVrldJ9LF5+hgexB8dy/fH3kytknPaviXVMk1WizKpJE79RmwIDYbEoTNKPvPDTHHvyycR
-mchX8wyGluaZRDB+ZjzrcoY+F+H0RNV+ajA4Ogo/OlFpJnTEtRHpM09lSW3f3LKoHg/20leFLq943FGo
-1SRxbK1dR3KT6VDBAN+bcRt/fSQOMjztKWO2U2+qaMEWbM+q6E31VzyajJ+wSTPXP5JnGlkaZO3zDg9Y
-xHgw7eRnWef8Hb3OKS74ddGUtFa3kLNNxMzbtumJeAL3a+5JgE9944vI9/dYqj1jBN10WHSz/T6YDNFV
-SfbwOcHWcMa3aCgRJXb5fZyl607vznxy7GGh0XHdEfZ5O8js+98ZIQEdMY0xTAT/lmje/4sqmGZlUlRf
-8EUUTrUGNYzqyh3V/7K2e/enrYC94o1YMV5Wt2qZ/f9RaIM+2gWdpo+1AEYITa0hW89tAC4JzSVKk4xK
-ls7ofO/1Ak31Zc4IuZ4wvgLkCsRubsV9xiuygq+suTYURMOvK06qPDkgvFYeLW84TeHzvudwVk1RVasZ
-oqwCgRtL427a9GOrHQlLxgyn706oZnUan8YW1qwN0WRck4r+nMTRqz0atiBa2W3dbZDXRpYBcCpqo7xW
-EyiSiBY4MVVdN3qcqRGjVwIjSRv7/2xX1CijssexgdieHK92O+FwH/V8mWS1kyULBHheMRIgGy8cdkid
-t9tXjlTw8Jaj6ejosjvWnp/Vo6MPTswn1dQ/08a4idjt4nwL4JKtiRsBwxhWxpFqv6dqP7o3ouPz3slj
-L2CrpYYg0gohuav2cGfbK4dnnHI6KAIceTR0HCTsMr0RtA6g5M7DRFe46aXeqHA5fPj+XJg2eC7lSitm
-uOmOhuO/uqfVYDZTWn8zqDXxwxiXi6yvXOetXYRpqb27q6kmUrDPypzuTghWlpp8OKTaOej3Jjr0dqEZ
-eau80Brg8nWvadAen5+Is7bVBxaCyHWPNdKBLS2ti3lDvr2WG5ZlNO+P1EUEiaw6b+KbXjATdU6T1x89
-juYdAvAL7LcN6A92W0nbynM9oy/uQlq2XP56kLwDMtM5640OZ5FrlcQ3hLSyWAyS1oOqPfkE2pWXv6La
-n21o37NMoJwje/QPTWx1qGcupL0rV8iFZbFypEi18fUKnrAKbUkU64lKC2VVnxPqmwoChdhsPvPHm/GG
-zYxoCOB303ib4d0vpUHlmZFjexYZVqwox9Q5edgDsekLmGCnaqMxVMWbeDw1xfVH7YZKHWP1roLB4HCc
-2/rEyOqtscsxGkeeq/hCtRAIgy/fxysvaJyadCyzkAqWM3Ruv04oys/FSgIgYq9T+sieUYbk96kI8fBq
-dxaBFjAb2Uvef2H9rl3MNc4jV3zyXdVCnHfPOIHhCRpHnwi6HHG2S5tRkoJFj3e6tOajJmkSoZ2IYZat
-0Alh9GNr/k3VwGNixUZfI/618frY0D3oecdeTys1Oxm9c6QjX5pjQ5TFang/ozlJZ0i4jwepS3BzfV21
-bsdaJBiUuwM+VMxsyRqzRcGvlNVMosjNueRId76vLNJkc3AwbjXkRROsuYa/UHFUK0DlsFLONQwG1kgZ
-GPfPsQEliGt8Gm5TBpWCMhBXUiAeGGjaEA/65Gyqe2UKcdADO/Geh7u6lhc+YPRol3OO87sfqhaY6Hiv
-2dwTWVTdgnusHELC9Y1Mu5lr1ndT+/LRKjgF2pkaiJAmMESlai0M8C/tCWIzDnZmYwLxACQurGixsoRd
-pP8NhIP8rINo64G4MKw1OqJs4lctirx2VQ7lRPPcQFiz3tBxY2c3Dt6uGJ6+qgMZH90lW5xPVBPRuZUG
-PDWEl6wY1ExLHIzz68piYCWOG2yGo+J2vxc4VF+HmRBu8lprnQDZ3uRrHcPXGGe1LYL5GPpOLWlzNNhW
-Nfx7EyCxiZcY7Ox9n+xSr/LQkyfPcMoI0o+ecimWUCtQmtAnzDR6zDt6hnTD1ZfnkHEdKuetm5akedPO
-Yv1rWb+5Pb5kk4kfR4ZehWQ/dsgmOTdxZF6zXW5A/BP8kV5YAIhMrmtvu6xNm1epX1WuKZ/1M+HAJu+d
-A6AFbzsiMFT3wTmYDPi229yYPOHNHYnbwXVCZjlKfbKzHox7o0XnjtmCc3ZsF7AR82HW0B/PqUWT0txv
-2NuxEpQFvjkqK3xvkrWYwww+0fSNWjJTxIeX9Q72BR83rWXDXGRr3djVEriuJPdYyT2LcgUyztIZrZNV
-Cd5R17Ygvswc9OpDw355j3Bumgb2POKNJjBZaLhjD/1nzS3D7kLqWdXgsPPoWUOgQ1uyf9uevBeue0fE
-Fg1Xj82AVmsCfT59xQbPJX556QzNdMCxhenQ68UBJsbR0Q8gI2WO4DaI8dz1vKJ5JBmwmqbk2ZEXptb3
-dS6sOMmnda2zKTVy6F/rfnHIUpRCHa6OjniVObKUIiqjVFxE5jvVINlvs81TDVgs6ijNxSwh3CXidsGg
-Y4ZMWT++GF+IihMuDy9mAI5h9+RBQ7kQTmmos6n9gZnxC5U7+iD5LMwDqzJ6pScURb/hXlw2qMA9uk87
-yF5Flp/P7+LniUEj3n81gwjA4pyo0pmVc0rTy29j+Hvz6Qn42Dlue2ufs1TTwSrWs3XcBPS+QxQBtxIL
-jaLfFhZ/sM2hooIGTcK0LrzRaPKbZvWKJrPNdGLvIegL2tP4OAzLsHQp8jQSU+I2O+a0z9m7NpEbdZoS
-1alpb6ITNl1MXd5Y2hvsVWYelK4qgSpd3iXyrJ8ydycoG5k1Po1LgJOZURC4rdt6l6bXuARUWCM3lxgy
-s07vJfp2lZbZwloUqS9oVVxYfJQ8WkwvgIoCn5SHtOr7I57+5uo1B2PLOPrO5pu3Nl+z9TSnfR446z/m
-ZV9CXQo0aF18KSj4huPMXY4zM2eV4fNK2RyW4tcu6/CoawhoASEZ8qFhvIBvIu0wmIFvOn0g077R0sHf
-429K2vJK2UN85mUcWKFMvC5HGfCDYkzRDZSQmXQ3/EVgie46hCiWSUuRmP0vNKlxtCu21PhHyLq2psKb
-mhu3zaiAoTayNtnOxMZeqL8KwszAHam16Xooyxgu/hYh7Jv42r/Z0OeE3eiH3tdfvvIYclJszvUkUHH5
-IZuHhyOl4Urpyasuivyq9ELWKm0bHlwV8bqtTPW/9dil82p66zJuZ7Q0yOLi3yYgCiCOGgY1uTneV00E
-89OQ9JnMVdUWete2qR3ye+M3wvHob1nBpBFea/NZOatc7TSk9eiAb3FidI/inzrOavUlZ7Xfm3f68NTO
-sDJxrs6aXz6cimnnoFk9SJSPIzIOpPadHgwa9R7fPhWy/alQNT0uBaEUtcmahbX0k3mg/aBDcb9lUSgH
-DO0QVhJs2SINjuBopFF+1Fbrg/JFpX6q1C+VuldFx/FqfRGf+tPfg9n9UygtfEuBOYCpqw+n5X3oNMjH
-4Fj9wRoRVb7eFkA7357lVZVfblfJEh4p1a/8OQNZzKetPznsz6cJkXEDKGL8jM+XcXGeZsfq37V+yO++
-17vqeQF0IO5p1ZDfOj5PDtfXwTTu//H3Wc/E+6cbb9rrz4JIR9cRvq6imy9+ePYbMZirfP6WWMYfKWSd
-lykrTnjxWZmvaIPy1Pu0TM/SFTU79C7SxSLJPMZOg+dVm/hflJj2QCLSNXZ3OFRLmm2/MPx7+HA43Kn/
-VNHUe5UDq/snBoVX3hfcUfQAiBNvppKMovySnL2Fgan3A/37Pv8DAOGlN6v5xKp2h6J9O1tPQ/oOiqFr
-nlTYNar8Z5rSmqjrVVpvcAS6t8I1Q7ZPw7AnoyQjiqWXqXYJhtaumbCsfQsH7UjlZdr90zWw2kFy607z
-5P7pDqaqzrpou7sxivBD9p7ium4z/gxxhQIMLcGJZ8SseBY55sT5amFrouCnSwDldZiqJpyCiAFugFgf
-+q1YEVzZCDZ/8wORD7hIuLtYtaI4tWkg/S+UImFbhuTKJy7YLf8wdVB2W5nRqRpKHxdOHwfaWASc1K1d
-Uzml7DXE6wjt6IlqorsL7uGQV9BQ9HYO1nnZBm/7kyvVFscV3exAXRn3mQYX3mCp/cRMZFoboKSPS6aZ
-4mlG9NPMTkS84dKlZhxj60T9UDRauInGH5VNR9s0gjodxpYX+VXH7k0TmBvF92W0e3RpWeg4gfFg2XFU
-7vuqTCZaYx8F+/oERAH+LadhpkuZmJNBJzSvknbXUuSlllsfc7z1t736mH3IXH//wty1jmx9+ngidvRG
-XpixIyXK9zn7Egxv5rTPXmYM1QfrrGW6Wv2gyzpsbKL0tkqz5Bv7ltfR2O2BPNCxlbG911W6ICoPT3+I
-QyI85fkl4ytRBV4wdNeNt1zlceU5Ol307SuETTzzRHObp4S8AGrjw6rt+R6Q2bUXp6SF3dtQxpX51UDY
-jlwfN1nAoIUcizVHZe5yhZkl9xvvER0IG7UKwBDxh9ofmvO6monzM7OVl7V7p5L5h1L7daKlkWpfUHm4
-gTYyGAmrUu7YO0UxJ/ynZsCFaSsi2vtGs94ouJ9PT2Y9dubEHefXKwkiHuNNEiIrbWaLXde4mETmafk8
-fu4zirX5ANwr3S6ZQdIhRS/y1tdeAyEBXshZzvVSfJJ4Gh+bdXpriGO4t4faXLbwGF8XIH3UKxdJwcAL
-Ze0yq+TmldZlFnUT92jAyFucsND6CHPoI/A065gmt497LQz90xHX8+OvjHtrqON6qIfgtGksBLwqluWb
-sZkA7eZwIaNHgi+m/iWR/lUxLrKnXQwVdGJGzijH2oZ1KGJDGiXqSYhnJpB7wW9ejE2GpdZP88s1UVkL
-HqGJ/5MDwFQ1nc40YuLaC1hOO6JWuz0QaV6cqifnwioqJ6XgJAm8BN9NUI9vt+ixsHJWXL1AfM+IjO6i
-V9jThHNoBKBTRbKwohXxc2X8bfF9+2bAvoGIktkMLtPsF36J8RJfy0sd7oSadNEKbdF5mLDUTZMrJ1UM
-kR9xSHEbs89g2L7UtMBPne5Qkka0/2F339KvstaBwwpJRTZjZYYNTym32w7/aPcb+AmG9ynorGCIKsz/
-WIRy/JH3I36MmjXnMNpV5ZuHU+Vl+kdijqXk0gvRr5vBOr1OGJawh+3EJEjdnNGlPPdXE/HCFK7cW5y2
-ucu/jSuDWk49+T6uLjBGPq0/2iv7uHIZBkHPL8SzAXaysHLcs+w7ZohwX+IXEyIN2OVPaFz+eMHkYaj9
-T7GC7oh4kdgIox8+zsd5LzoJPOG49M2jH/dq+qj3n4q9FAx5H6DlbbM2kfs1Vb/W3omaiUzuh/sJdH0l
-fs/7RRxlSbogdCvSmXcderhX87vzrqk9269la6wQM3L7LhkITCfnFJo3IUGUoTljR7B3ll/TxKLq0ZYr
-telrLB2nkjqKp6lQOl6HjwH7r/EkmI5IZS9mOSd9dr5qfE5eU9DYtKsmtVKpdFzw2dxRr5+SVQomfLtN
-WdJTZ5O6m3hKu9rQkMVpz0xA2uririkH4WfAS6bu3JUjMomJ0/oadmau09ks2ggFqWpeh04a+vKiiugf
-pBjeIzHCO+C/UnD08fDjA9n48CQu1vB4/NgLuJc97R7MsxxRyJz6wWF6ie6IUWcjt3qV4wK7uTtCe49q
-ACFbw8xvu20E1iI6k15VgyuiGxKq+CGFgWg6wLXg40fyV0waWI0KBuAw5bW98KLSyITww8C9Bbu+rO7R
-TcP663rvjidptokvP9h+GH2SQaeuZhDHDiK8VhYsdrWnuERLKGQt7Luvc6iNPc98vEnZvW4oGE/1Mjo6
-+lVP2H3RAC57y6sY6nQ/VmqfVyqNq0o60uxz2OEwrrbZK46OeJmaBpvvk9hQZP8Hqxd4mUE4bDvB05yJ
-IdvE/ZwO3OuumtD5VveLz+gn7vk1aR1n2sDSeMOTV/GBPxkMR/edlSxysMG9Ea1PL6yYCfO8Wx1Qmy1B
-LvudMpX4mzfkXBXQ6QcZoW/a5fVGw+F9mHOhANodWW9Vqgbf2ubJ88bZAIxYNFJ+9TgCKArvtuwRUu5B
-oUNTXy3eExU83M207g/g/HZfg0xKMiIaARM+LOpe8k1lonv1bV+jOBrUnJEvtGVrU+ZqPRDrvfR7PuxY
-qNcc8sv6Q5f/SrME60l/YyWLaQZmt28EjL9UapqYY1XEhzMBU3HvO5l6eaGFmAzJkA3WzqvxQshCyJXI
-HP9HK9tZ18QJCbdQH0CFoF3YIv2AMuFTITR+KrV0YV1YN18yEqXAmDkBAxG2dlGldFq2dhQnRA7nho67
-GadvOMcfOFppZF8goxIjEkva8q6OLco6TjUVZQlxpxe5w84m8e1CjaXJQ4oVowkbPMrmE2oKZl+fyQ5S
-0gNcl1w2hvvq5KBn4KlmH4Aim2QOpngINl8TiKLulFLW/4GuJArIWU2f/vZP+HfoWCjv1M+OvYDfqht4
-5SjPjKvEeRYd//1keHyu1vR0Oj2d3TtWSzwWk9OMgheZGFcK7uFWu2ZIL+PzZFsklNmW+jJhY8uL7A6/
-i9u3yYfzJAuO0ybMT2kMIjqR3diA0df4NzqiyBkx7M2g2+5qOq/btId7uaNJtKpWfQjD2siBARER3q4L
-NchBlsVANu6eMkZMPDQSvZTooNB4osDN9kXWhUxHKRaZY+qvb4n0bf6hVa7CRVuzcU1ahMtkV5xNRo8m
-Gv2GDVeeogmeddhU3KARYcVtUeKrt7YpWWbKOy1OM+BzB2FH1Kw7qmjhyRHNQxx1gFgZPcBm81q3fXTq
-Vb4BfqOzlA5UlU5TLQmeRewNNfn5p28hrqDdhYmynkdnY8eXKtiJdE+kL5D54Fr7ZVJVAMdg70HOO52K
-8YL31Zg9A5n+xLIzWDbar9CLVZxm2hlaAnUi3moSl6DKfTtnxDZKFEV3WsHI6gskwXkGdAVoTWeOzDzV
-DriOHFX5OXU6Hf+7mhk+b6tONaX3la2bQ+zh9L0hLmBtZ+UEmud0JCO3njf1evuQbHBO7sH/18xTqbjk
-rlWloNCoExxaNSvABvAY1w1O0eAqsMWknF0F1R3OUdfVAyzjAaMyHmioxgOD0XgAuPgD2qlomzgQPwQH
-giF/wPCkB4uzlTwwQDLQEuVps5ZfkDIHFlP5wMAoH9SQywc1zPKBoE4eaBPvGgOY8zVYwHig7JOiyGkH
-rn3ONp1K7GvMVrNuOPS9C5ChXEXw2GlDq8wgj2sAuGpf0eICDbwDpK5uMWiKutFQgIFJ11kTsa3p2//C
-YBAK4j1f3myyvSStBIBSNBj5tRODP4c6ZLwJzBAq45ZUDcCRdg9OnOLhx1F3HgIqQJEhjB2Myyl6makP
-mXqfRQad/Qwn6ORYXdPv3wZA0ruiJ386OZoFr6Pp70ez+8fqFZ+Xg/sTOu0PTqsZ7u+xRQIhoZjcOz6/
-VE/1kRqf0ZTbEiuJf/2yygucv4Nen8euFPyIFZ/IW+IQgbwYUqHPdfKvv3y1/ebLJ89w+f8WYafHp8fH
-6kv+PD29ooxmvZA9F9MHVON48rdQfBmHPqAitvTfsXqJFtKMwfJRTzJQMm/477Ms8u4fe8YxD7SV2cDw
-A8N2F8lSS+G/o26nsD3T+hgoExnHhMd8iqLfdpdZ9GUmArsPWVMjkv2Q1lvbNy5F6mxeWQcKqWzvlaoi
-rqo5b4Z03FTNQlwXqbNxW+8oCzRqSEEkGauw0X4LKTxRZCDIC3u9zyrcStweJEy9TWfBYJOJw8cMkrbW
-N43O5tiHvGtfWaDr4wgGrG+yuiNKfyXfN5bFxhXF4dCQuAnfYEwbrIZOMo9W4mrT3hS2u26+3cYgOeez
-STw59DfRnBZHSBycUfUqbavmgSrxB+j8gdpY/T03MnSiiKrJp9Q9s6OjkueOY2nUpAjYuKdxBi9XcfWD
-WCizvzp7bGQBFCa0FxCfRmc2ScICN1PwPR3AK1XGluKGgXJ8MVBXgbdS+sIc031PzN6JOHl09DIzMr+X
-WTcC7NgV0PO3Fdug6Nuvg5oKXYmb0NSiS9FGbt2FJTZ0yFdOrXk5sQCmIXQXOlyB+nHkvfjh5StcqTl6
-2R2qfLGjxgepmaj1hdkOYrYs8V2qMa/3UsqWDTPTCcSGjLhhVdOY7isT6C2zlIxRM1NRvqJd5HINBUYY
-2jYPB32NXkBje8ruW4h0K1k5lvb0ZMaA1qzoZnlrni3EgkB4Js/MbOPxqS5Hv36J89jE2rAGhHmDHczs
-T4/krmOodX8vXtxDGrK4rL7PF+kyTRbwrJVU8Tm7bncmd8iDQCeLo1CZlt/l83gVPtXE2GU2Hc0Cq0wJ
-NNUcNWetWHrV6pYWeVVGERMznbMfiePr/tXVVR/I930qTsjixZideoFX/PnVV/3PPCX6rbiev++Fz6hK
-AClhy6PjNYhbTwz0JUQmyTXeGyVdrtQBR7jG9zclK3Y5ERCiYwCGR6uMOghxN8gTqY+lOC7pWHLi1Mew
-H5dJ8RVD5EgSzwT++v13nq67O3lMZUzYP1/+8FzKJZIIkgK0myvmhS950StuKYuSobeqX5ELNBd4ciMT
-HY72mmCqwk4525aMshkhhsTd2XmwWd9CHk1oZ+TNsbkfAs89pPBmKGPzIeAFHakiqaTT8kkWcOCrIs5K
-yGUQ+EYHtiRj+5DLfIImShxuQCeKNt89u3I2x7B12axh+JjBD+7a4KESW6EW9SvlunTMXJaajYIca2m9
-JKkLyvNZsiQCGq6Q2DD9abxaQXGghGf3OZzfXeYFNMouKfOyiqtN+VSjFqoPODff488ZHflXkSe+W4gp
-V08j6uF48YF2jArLlCipn/Sc+IYhufbVT0EUnNARfCZehefBzRzWoxpNLHqlqZc4CObTah+mIaqmJ7Nd
-Fc2nLVvO2a7BtlfCtlc71OnJatWsVtkh/eBKTWKNyVmiJdSZZbXXEFfW3KiCOYjO4D0teg8J5HtWZ0jU
-B9yGVGa3BedQpIvk+/RSfMZ0bIrIZD241DGixKStB6e7bxn89uTxGavMa+3HS2y800vx4VjNZsIyPh3E
-q6v4Q0kEzlM95i3sb0WEdNEJcLrdXpmYuAYfcETYDr314VlJ6srigQuIji5T6p+n9VEVncP6V1GxcnBE
-T/lopADm8uh1GadQqKe1Hvl+AmfF9LjdfshwDVCz7NeZcl/fZop3+J53fEyTeS2W2hn8R17kC0jxxVJi
-bUMkCsW0JJaR39dBYk94O6Hr0WEns44WZpHTaZJf0h7P2jaGGuf6twhy1YgeHbItCS7UaSZyM46OcKEu
-b3Dl7xfTB1CmIbKiWodCOY9mE++zoRd6Dx8+8FihB4dcKxrn1ojHpYu59XrgHII1Cqcl00w83SORkTPK
-KzrZEewE6h22SrUmsu9poGSl65nydLyiHpKzV62OjoZ8WSJnPHwztFHXHWLEjqX8NNWC6dtFXBpzosPn
-+qyXqICQ4M5vxOLFpZulv/ci/yyrLauOqKsmXtDTrdQ2IvLGIwejGIN1LxP1Kuu4jbmiGXpv9Dryeu+z
-Xg/3Mp3FeDYGMk+XhtjhewWX+mEDlqeD9v7ke98u+yZO/2VKOzSRFO2UTFsR6XRXJs9pIfa/x+z26thU
-K7+eL3U/4s0hlQ7FSUDmhgXdJekM+ojiqUYugepK8IRJKs9dqozKttbGROW0+WU2ufVLD2zTIZarGzzx
-1IHXe5b1vPHBu2g4GPJNYxDW2YDpCmqeiTpCTpOgo76psp8FYWtJHSjW4KCPedLUr2JTsVRP1TrQnhZk
-6di1o7fYYHwFBE169GxFbvQuGo4Ub5/0azbacLQLnlL5/tpUYhO9A9miF2hw83RQn+DRCMty0VqAbNU+
-pZrNMDWZQkavQ4Up31RggmAGm1SvJMAV95taezoyoz2blIEIPc6o1A31H9H7H9Rb4xT+uZAHfJIFbPB0
-8Hz81u+P1HPiEfj84jfveX5gKTLP0WN622T+5+qDeq+u1Cv1PMrGJzT4Z1Tvs+gE2oWsammqz+7gKxVD
-o8UjEsftn+TxcPIwBPpP8jg6GVLTHwyHj+mAeDB8CD0BoBj7V9H3mb+moYRu4lX0A16u6JVY+/nEby3u
-V3TW7VFNvvcdrVu7nGn3e9W1D0Sv6EN3eixbm0yvYYpOG7JUlJoGCRfOBtkmJ88jL8uNQkyo2yOh1aWp
-SOg/j66YViCShp54a3xPDzzvqFsO30M08z56rnBoHz7HjQzlkQivSF3FgMlDHD+G5ogS+wjeIoK4+zlO
-eeqtC/Cs+eq9GAAt1fSDeq6ezoIQH0Bhm/CnFP5+VmcK+si/BDlbNaf0fNJgWEOXlcUcp0I/hMjpHMZi
-iZM/hcGnbmt11EyxXiH9vjnSav9TrQONOGrHofxTJlHB/dwORi7qrhDeeswzBZzmJTN8txklOGmMRemu
-vredskat8uCDbv8WHaRrUyMGzou67N7EWCTKt1v4+BGPQapDKJLVQpFc5CGFMttWumsCSXzfpZzJYh/D
-2bJWpt27NfNAuzNc2UNERvsJi9Rw+GvH85TAENM4oDrWTONEClxYKGz2K9DVq2lJJw7+1sbrGyvAK41v
-CGyzVA1KVQQx14gp7hubmQjEDjca/qhmnKclq23gwyy4iaPS5MjWNWWwi6E+npq5E0/8+FDaDCrcVAQq
-zIAZcHUwf7gdBQCs3dztUC0pEzAwCExqt1NOXYPNNG5zZ422xLNxHs3NUBjrZfRjLYsSsYNY5WbTjnBm
-mwB8wD52UcWvmCXHVW/kBrCfMNMERgrIlVM8gxXwDMmDPFrZyzWhBCh/4InkYnVMHbricchpeDZ07POj
-OowDe/ZvkF8Zpe4dVCmke87CQpMFExw2F34LMLLQMJ8gWjoLNyJ6PRyKIQM03eb1rMIAODMr5pgonmix
-ZGqsgWdBFTE+ETcMB6u86/N0bW+oefcOPRauFHpjFrIhnhC3QOepDCGuSdjzO1UbTaly9MJut2vmUxrR
-Hy/qCmg5jvzCysFkC9ICr1papQ4aAq1bwoEv3RV+3a+/NOReurROgOqWnIoFTjqLbitfB31NPOjvzA5n
-5UO+tdd2c0gskyCuteUNXhmTFp+oIcVEZpnoEiO5CJCiLI3TXRSvKSfPGiAoZiqUdVHxoCHX9vTpx2ZU
-G8C/cpe9fwFlIQoyoQdxW5IMJPSpSEJZtc1IRVvf2HlTgS0HfFk2yPmGOcIDE1s8tbQH0KbcBZenh5lD
-km23x0ibLLaG5D3WavdOJDbsubsYvjVtYtvegVILER5fs9Je7BMdqOwygKJNsYda2fTg0ZKqUA+jMKkd
-dbn4E9R3pV+xB68vcB0aBacTfxIdbe8F29PJ6eR43FhqIAxo/c61ZE9ktGsj6NvHBv0qE4R+JlMEyYG4
-UGFCXSkQJFcY3s5pjzJYjLt25mOHmVA24EiHml/+IjODRBOAuGD6yzZqLR0vzXAe+g2GUvAN7Q3Qnwrn
-oTtUF8j8+9GRh1/n7ogylWYwkGuDLQQ2xaDRmU2lmtZH+BluBEDhphmiVhNBjaA/VlDwBQsKaIu10U1v
-ZS3xhOk1IzvQ0VlPJ8facs5gvVRF1O4QdbVKMo8/9n8fKqNXcXlAFP8BphFzHuiCnWp2SSRUKC5TYdiA
-P27OZX2pBf+NWsroROAEscr0sd/q3KLVWV9ZSBO2Zmv0PYYy9gUqgoqNqp0DoEKnkUYGyNRPmfoFvkbv
-QWr7hOnzX0XDyLk000uDGesEp/yLLHgB6DW9KmsS9Vv2Rk6HrBE6J1dEG/36/XffVNVaCwL0yVvBlM4m
-/KMzYaNCvvd9iq08X1ac46tXL7zAzax1p3t9UbTbNNkbZ3Gcq6/Djo5Q/+0WldmF36J32hfFlKnfsEbM
-izI6PPwJt6NXxBQ9LZIFDXAar0rY5f2k87CgOpQXR1c/ZeaStD7CXEVP0MGZe3Q1UHCpVH2b3X1AuU5V
-eZvheou7kg3twdAPm6wG+TrBOmVRb6YPHT64VB2Nd/+yvMqLRRDekQQLjEoRArXmD9xAMAiRE0Cv48yy
-H0RqDto3AF1hfp2EF7XbRenU+7Wv51my6DNsIFvgd4VHXnNiOnDWXPU0WO3Lr0qVArZHz7sNzbuVCGmy
-hvhPNgax7gkannzNEVDKvRYXSePCPp2224eMDOwe0fgWiQ3cquuEhrJQvqYFTOy8CGNpacYw/AkeHjYz
-Q28acRkzXmsxvl9p6QCxPKtB572QH+z7Q1w1Ls5ZcMeYnM1w6dO5LQNhuvOWuOPyvF3JtItegywlrYd0
-Mjo5eUB9UrI07WT4MAjLSAqaEIERPhw+3OnsFrRottsc0i+iJtZHRzkNFvWw2gAoXCbppN2/E0dAV8BO
-Ler1fsm4N/0XbLPEmkms/y4Kf/49WIJyHwOruXNMKKfC76JoYDvRJmV+zdTPmfq3VrISsIAtzPi3MN6H
-stVvmQN7Mgk18sk2MFApgpJSw6n8k/KiObtJWGGbMviaqKWsmKkfqTG4eZ923dqJhrDAhF0libZeguvP
-+QabXhr9lhmbR+Ig06OjVO5TXKvtZAbtWbHZjqPWJzGBPGRWsAcJiclQmzINoEINx5I0J0e0bZ0wKgTN
-/JgKEjaURSp4peqkrH1EI1Zst6PxIj9gvDxv8AmdwMdRqYztrslXxUQGGEw1yo4mlG7bMSoDcESaZv3+
-ysqgUmZZM0zdoop6tJ5R1hAbX5ZWxEhngNqJUtwixT0/1Qby6fRkFvbwF3ZeM+d8/JdLYXSKhn/N+LD+
-1WoB1mfkf9pglpH/I3RHRNFLK81RCIvjaYRgH5o20FRiNhPtyZ6SEpkh8vUMWi9WpO5gwGifwQ3NKeT6
-tUHjYBP1+g69g6bRe9KKx2AH8YPzEQaRxhXsaCx3qL/SqkM3ES/h2NNuZAzQYb3NYLEpmLDtywQ9rkMw
-Onk06heMJgPT3wpzuWx0w0ojp5iPtJRhfOzXatBQpK/S5QeWcyZqulE01tSpo8c0dYlKDf2yIYJFlJmo
-qNEmWtq73Ru0OkygTrMuQxe4GWsIEgBXW+xG48R9GZcwGCGSBmoOcGedZvFKm7inSRlWNtBof2TK9k9o
-e9B0ChHO5lFJi0NaOM5SD5uKjmJkcj0w28AG3jvYZaF+HDQqylNQf0g4xPaj7X6mVAFtj5v63FFHafi7
-ryat8Qp5B2h6UWdZE7Fe4+JxNs7cYcxkGEcOKPn+MKlqRmeIKyc3ofp2HhiZGzbskLKqwp93tjtorqev
-M7ugNrTZmDT1uhpb0QnMJObqP9SdLY07UwzGMoDosn41Hvg4yfKab4sK306flTJzLc7Sy3Cj+AAIdQ78
-sgtgKm/8vZmyzLvWwtOhrHagn40EIWDtAxMDz3bB6zB5c7asqthDelJxDYmeSM+1QFLSiMHcxSWjYxGR
-i400YEj0xyhnDge+jgv26lBQkLmTThh7IHYxNChX3OQLWw/6PGZVQ83nA2XSpi1mdTXzQGoL/RUpleoF
-tIwoDeTODRWOUuJAnlDn80KrUdqTQt3wBN3Tlmkj5UFyq5JINvCQVTOtFHXsIls3MbJq1ytszkEnANWM
-//IByU9WbFqJI6sOV57Ef02+zmzEJAi/to4lG/cQ2f6pYEXmrIkKzSUG0BWT02UDJge4YsDStfD6ylte
-g9zxAN/HE5X6GefK65qGkVgQrLE+CZSD+SOuCusX3vG1nxi+plLuS4MVt2m22xXwkVQd0uvRxN8/yLrC
-6kT9Pk1UfjRV1UO03bp1YCimQHyhup3iG9NsWKoQDSP22Wy2IiI64oGWq/wqmq7ts6off3Wef5spbWXa
-DdPmGEjaj4KVFDSgBSSPL2Co+hy++L+LPxCpQql15uAtAFdjLc4ma23+G65rQLWGvSt7+jH1ZIbBtsti
-8NUVKC+I33j7SxGvuRIlzpj9IXDyqPPGTYHTO+6XkfvlN/fLycy44i/EYIjhGngr+remU1OBX9WbhN6l
-cmDnM9WOPsV1H5GWywlalEB6x1M7gKAuzTbJeI6tgubtglX0a3yZItgJbnJafon5ooUecypyMTHdQ/XC
-lF9GC210GoRdK0nd7Njhi2+iRYfLQC3FjFcjhG1aWtfAtExqwDC1/1106ag0Efo+a69eq1k3D+pmVWoO
-GIqd07PzII6Ill1O0AUhnfs40/gLrUYfgbQl85mnluz1AjS2CdG/DpwHbM/16sEbgFEa/jCKwkVZceRL
-RYHDr8pZcyolgt6Jt9PUT1QUyo0X3dBAEie8Aap+SB+Rbs+SSO6FBbCNDuVI2+KBpogypYHcQEKwMkV5
-xbgDou4t9FykPZtJW7Vl55Vm0Zgbk0yoYwp5FIakxY1lNTe2U5RuX9otbVvL8Wgrae2A2Xb6PKnEGYH2
-BummsRji9fedIiJsXzkSoIx3FyYhOTU+cvvC0q6ANdYEZ92DM42vuxf7fqKGatT9LQiN80DqVN90Zb/u
-8uB+1avfmpmUVbLWHsncoBr+VdhNk7/SQLWQCRHLN+G/d/ak/a4JUrU3UZ356H5Tbn7RjfVr2rL3Nyu5
-oRiccK2niQwJbt/xbgzoBbPaDTMxQbToc0Sz2RwO9VOF04KhlBh1rqJ1GTaLacNVsDnENXenzX8vBJRJ
-s3Z+R/UcYDWdromfpgODGhayUX2ca1e9hJdVu9Z8cl/t7MDo8ROz0Fe0xDuCgT0V3bRbq2vsYghyQMNx
-TVfhQNJ2cB/48FFy1Cg5eNp2JtqgW6xNxh2GoI3dUaYE7U0dCJW3GCSJbWPM9G/ip1rMpSTjPSvRZUxN
-zv/EBFPfpmWZhv8x+JNqaE4xQTq2pVo4y2pnTTf1t9ucPkTtA5c1Y6/B6SXih6kAE9E+BiMoVqADXJ4e
-SYMxxLjWLxIjAyxpzZYXDHVCq5vYX59vTYyyykC+R7GC/FKoSFZ2dKCzY92/QmLqSCoO2ux0w699h4I6
-Fz82XA6/KUg+d7cZ6onve9iAGSsNcTuPGzinRpgrRPWq6cwcTZ2w3YdDfUVMq5Xy6Xk1ie9xtzNXW7oO
-t7AR8sUFEF9nkAUSN4N6Hx2lPl4cS29m0+L9iP/Masw5k4jjW79A46zfHwdg7Hi1HYoHXpHfc135E9f2
-kFeGzwGYXDKiBbClAPzFPNsc19vwpcoX8wXD/C4S6SqeEwIPLgPfpV5huthPIt21d/Wrypr9BaHY1PSu
-B1lp/SqdPWv2djEpaokL94yZlGzv6VachxjCXzMI/OuAn7MbyrpjK+nYSjpWuzZGf1YzO9UF0Lly+5Nd
-Fpq+rLgvmb6MhuP4ccXubAtKAVMASieVbbzUNQrMfDeNaunVpU0hRXQj1GSY7Kxf4EoA98YPH6fjtBed
-9Cvitv/DEALF1KDi9QAUOq3h7ei1RvLAMrJYUYUGeEwcdK6bckX79rP8KgtTKM4wTa048Oc1B/G+roNe
-CaYwgvX2T/Q97anfZjWmr+Sx4/AfNpXzgXOSDzqj+pvObvfn3rb2N2yzD1vDeYHPwlYatZU4tWPUDqtS
-wKa4myrAhLXaNkCz4RJOMEgd2QltJrW4k3YqkaBK1ErDZzhwHxRu99/CkoUR0xr5cjkZhjVirUHIrenQ
-+jGsH7H1CK2C5pYT53lax5qFTnjtgMcg5Rr5R1GfAkNG39XvshHQRFoR4W8Fc6p+jBqIVq5CKtIEgO6i
-X2dtKJ313g6lw0HOC63B/MoN2Pm40+8DLRfmZDq+DT7psyx/nhOZeJ8fX3wbHJ8wFSObENR50DXRHrFr
-xJ3zt+1TOOF9T29ixn9kfXNivUFo+VhCuyBx7Amu+PEkG3lmNpmi36dtxibCFGPKE8pAiq9lHMFr42yl
-HNF9UhEjMmOcrmthIPxAp02Be/E+XkWjB6r+7LbrZ1w6/pzBcOBbHdm3HdDMJDC5oo5uHqy3b1P/nAXq
-Zzl1TXyefUSOrvKr8NPhkDaCsgqhr2VZBnZtYElvvgL9i/heeg9YdFk0aydCdszcWwDrZwCOKuWySI+E
-oR0FCCxq6fft+X52M3Lc+u17INEZgt8S8DDXVfjYev+JbkBhDRWA1cIhoBK0FxZo1hCZ0QQOFo+gFu+5
-bCsUKtctPBFwxD/J/hKDg/4CeNW0hp6uUor7E22L2i00pNSd3332dQaVhUBxRfMB/e35cC19nvwmDeNZ
-btiToA+9Mc6A3oBQK00THFyT8td2SnAwTlK8Iu0uCHNMDj04N7Yzuz3wOSiwGk3OC8Ye7s7TOYtvmOER
-tDYTI/KKZBVDjUeLwVPx6phHqS7Wt3J+5MyGBAY8GwEMicc+0moHIFpwtEyvkwW/8II1rqqEbVXTWJWz
-4HF/ZJTBIddWy/Fq4s+p8BoQT62jOfodrq4FlDj01030bNxMLt2gMuC+3zuZQGyYyx5AM4nQ+5AhRYje
-8jf4lbe+DPa6joKyOQ5jGst7X4/sMlAeUI3OWbA8qQb8Ut8rhSl32EaYS4dZs/5Tmle3DU9HPMjtpVKY
-pWK4C9vd1rVFPQ0mFR1pt8zx0NfoaDLeL7TjHFW5oayYVfuYZGBUtqxnbEiLJOizGBr9ZmYIxxRsPloR
-Fl4Y0bjjOuJh+tcR9dKTMeG8+7aBQhyyg5hDs9T0uEj27ajsNEariCi3vf8jaLq93tpurWcopodcL9bS
-S2zjahZixzplV1FullZKSHnvXCuam3rLgEa93U08ZXchCdf7UxMK0e4Vx78ZpeVgvEd+pn/uaKTWgsMG
-6WDF8tEQT4RdnIAfdJDU2zs2LBES/PEpqt1G/WJyTava2RxpluaqmOShE/4KxEPAyaOc0adQq/aRpVHv
-G3DjRcujlgAV45YwCT9v3B5Nase8aXIFyZ2IjyRFeDiyHIb2sWFhgAX+WkvS20NgEln4SnYR6fUSY08Q
-wsY89NiHKQU76VmUxAOWugNWj0a7A0DhOsImK3xIA0Gf91PteSAX+xDDcYUGszoY3z0XXNXr8X6vZlBO
-vnX8PTnxqI0z7vqs7nrWgW6f8FZtJWOsZtY1prmA9CpvvJkIsqhMhOZbXThNpDQSTzasLkVtKoFmobWc
-+NoB4nciKyZpWKnYzitDQQG+rkPXmfcKTWtJxDhbvExWS8HpognwBRSO9xi1y3yxgfzV/N4SAfRhXlTl
-pPkaXdOuPnjzI5A5aDrfi66VZ+pWZ0FTO4U/bvkdxJcL8+x7guoBoVMHpvT1DtCW/hWPcDD+6KPjYwD4
-VXmRUIMTen63SYvkTamLOBDoA9jzpNXH5QGxSQes03eQ0kSsWANcYi6QFfrsgP6fJZhucfEBCaGxCNLu
-IKcSioNVelbERZqUFkgshsOxjyhD3yjwPOMsgwOp50DXJTpofqfqf0+80IsiB0BbMXhNMV7D32+eLYl5
-gUJnM8I9inDPjVAVGzh6bMbiSy+E6tLdj5SB+yodKD0+uMirt8mHcvCmRCDgFMrw+Pg8rS42Z2BFj98k
-wEo8P27GPz5b5WfHl8RsJMXxflYf1afYIrixW+GFf97AyYrs3DgXqwVe1hfRuXZnWag3kXxpQVM4yhQ2
-br0aNPAKbxwwgx4QZ3bO9jrHFn1WABmPUzmVTJT6Clx7UUcV7UcGWwgMLppn0Bs9KYOl/gvbC1q/6F/0
-PD0b0HE9vyCGNRKwoAGszmAqxFa8+nMLfwMOeDyiM29247NBvKooJ1zCrBgicy5+FfDao06goaqKlcTA
-kxsF7xKHlkXMcfxDNwGCOUGgUyBAUrD+iMTiRzdfDkC0+WQ5jXvzWfTR4TD08Sz2NHhy+gPRn28uYW3D
-n00G2kvRcnp73IAK0TKBJHpjxJHzR8l4Lipby+mb6Xw2M8zaRfe9BhR1bSHRjbYADL3h4DMiZ+rxCm8+
-C9npEPs49tTnIbwTe2r0AIBQTP2q0aeh7hI1+kcona5GlA4DpEafgyjalJT4ZAiLpXXJahPqhOIm5dxT
-D04ovWT/4IFQUBuiKx88lGfAg9LbJxQb2AQPqLSL/BKRKQNmgtQDKoyTUFmFUAEPqSxJ+fATnPIw0aJn
-SrxIqHqf08OQfiiLEf1Q+hP6oeQPqMZDSvsQv6PQ+wS/VMFP8Uu1+wd+qWaf4Zdy/hy/lNl9/FJuPU99
-NBpSRn0KGFFGA/xSRsf4pYyWIzxQTssTPFBWS5Q5oryWKBS9uUSp6MslikVXLlEuunKJgtGRS5R8MkKG
-Qzxx1sj7BHmPkPnDhyzwk+4eoR/0KU2DIjU6OaEoPOnhFU7PtPDG+28iQv4fihZ6hxQp9P6L+jb0/kYd
-GHr3PEU5/d1TVNHfPUW1PPLUZ9wDVD/fUx6AZ+C7sk+/r+k38rhfvDH9hgf08LEXfux9rOgp9B7R3wH9
-PqbfY7ZxUt7pKT1svd1uvDDXgRonlj4yQKz8yp7TOCcXTefEfKk+i27otA8vmGbwmyeKPkMppwqqsc7m
-T3s/LMoG53l+vkr4AFgfy0sfH/omzbE9aV789PrlNz/8/N2z1z+//PL10x+ev/r2+c9PXn37w/MIJ9W4
-jvbqyRevf/n22atvos+cUPFiReTM4hviXSL7wTxxiR9e0LZZ/ZAlHaHR+zxdHAxNlq/Xxeu0/PbLT9sy
-xg9RFr9Pz2OiG46O7CNbxzw5F1OP/UCNgnR8evb9y2+/PPCnn/7js1lwOjgOxh+iD5Peh+loFi7jVZnc
-Wb7emD5YUTU9feSyevaM/IDjyyBkWdO575R3FF+uxw4S1PcUtKrckOcUco6QWmPmG/9MLUEvl1cp2nFW
-ewu+mdMxczAKBTgUh9w5Tr4W4NdShKCYsbmIbFYUNzauHEqVRSvrz3GpzZ1ScfkorB+RdG+ibNzvv3lM
-e3hQTN/MohX9GReDsmEq9U5d2pa/Y9zrR5f8M+mPQgkA0JMEDYH7Ml5FxQ4lvaGc3zzKxr3eG8rCZo/l
-ICgkphm0EAvOoHXWfhzR0iwEYvt/2ek25FcKebfJEaZoxUOBSZf+mE7Lj1Bf9KHjqXlFDVkNMqI3XqZn
-K+gjf+OveNzQp27U7faQbUvOii3Fe7tNL8+De8cWqsGOFm1zueLy2Kp+zGP9IOSfh6GO9kFPB/a0Zg3w
-HUAFTEX7toTLPMAl1L5riRrAtmWOXfkCkoa/jvWcswlGgZ503pkX6jSfSdW8yoZ8rkMyGzIa6qD3ddBI
-By3roBMdVNRBD3TQxpOme9f2G4vvvs0qql65OROC1D8J6GAn2q/RklGgcxmaXEbm4cQ8PDAPD83DJ+bh
-U/Pwj7vLHgXqo89wwSli+64+HbnKcKkZj0cPTvQI0PPo0wkNyTVOI/rxgh6EmS91CZ+CjuqiQOcChBHJ
-eFL78dQ3D1PzMPOCOcfozc0+Nq8rlKNCWPOLqNEuNdcbRH9kQOWMDdQp/W8zHfY/f9L/Ku4vZzcPd1uE
-XbthJxJGQQ9m9Ocfs5uhqsPodWRfT0/L09OXs21/O/29j5AZnZnnHtOPU1EDF7EFbUpvowUMfalZdKAn
-0Vt2w3YRLcxuljy6oP1E61ucU+RkZmb0uZnHp6dfmNE9PT2rH5/Vj4v68WX9WNaPv9SPV/QoS/OcNgqj
-Z7o7j5YIYFNDBkDsnTy6IJK8D9qZ6tUbzWCSvPTxfAItil50ItrsZXQ+jiXL6Tkk4GPmjMpHn36y3Z4/
-JgqK1qR5+3wI2ASJbCUfn36izoPtgxMtC0kzv1SfDxFCmZWPPv+Hzqcj6ef/oKRH/QcP3LQoEmEzmstx
-6wR4r67sCfCeBqd/xVAOUPXtv6c/tOMvMI7n0fR5/FzRv5lmCoY0XLEZOR61iyjGiAGfkTJ5FJ2LRRd+
-amskvKkLwI+I5slC9350ARyaKc382fgtLoZkW/2d9lN51gxGrOaBU4NFqwY8Z3TilOshoDso8TG/3lxw
-tfilLqePc8JJB3gU9JZ8ndUyslj7eHCP/BWWoZnpvBTzTTFPWisP0O6nU/o7/Z2W2ydP8efZrLGKgvu0
-frayRGUt0qJ0lqgTVi/Hz2c9efx9c403evFPTyfT8DCabaf8Epye/k7PXO4XunAT3Av0giXu1/QmrdQp
-WGesTh7nvWVJXXqOhezTfte7qIFvaKei4PP6wAKvSFF75419l/je80c05G+n57OoP9rJgI6oqLfugFLa
-PrT931KRAf5EvV7Go38RJW7N9mt1g2rR+9vpBXYc+CBg8VOAiBEGw9u1av3RX6625IFdGdnv9iuETH/X
-ewXNMno+tPuGJPZ4+x+k50SMJyCJjo6KwFlZjXbN3R7lVprL+ejk6EifGJJxjn3LNGxuyAX+dG7ppWMY
-vT7p/2d2fF4zNSV2tLJFUGjme+r1ug6xkreaknYm9jKys7afC2eV6HUhRmpMtxN1Kr/vKOySCDM95O8e
-XVKz3xl9t7PpOx7Syuml4I2wOKaF3JKZESpVZuXZhurDrj7pru0rryAsGuqFVH0Eb2qgZDl7XUFDn6E+
-a2yD77RMpKvSboUFbGe7JWaGKIsUeh8acJBh4ahjelUwXuvtBZOxt/KrAH7ibB/qfWOtu3JLtO2biXee
-Ep1x7u4+v4FmRA2XVLm27wWHL8gFXmYVQY0QY2HNW7NHBTUhC/RZm5q5llnq8ZSIRejH59QJwTjX+6Sz
-NlZw8Us09Vl/+fcz7qJlLyIiGwzIqh8RHZQbfqD1P8/JhWH0KZesN3KpaO+UKFO0TsIMvdbrLdnqI69d
-fHbVrPZymtezsu6+b4lf+2gp112QawU3Z9GNTKNwqebh2W6cUg/rrPUplKuzwcLN5QtwfUZ1FN7Fxg1W
-07JmZ8bUeEmjiU32HWJf0njgAlePx+WjisbjUrJbR8X0cqbm0Xr6gGfXPHCPGp2k318wr5dOLe2/CGbR
-erymdCPsIDTl1kyKvAPWwg9XmfH6zFYyb6Tj1sH43XQ+E32XnQ48np4O+6ebJf1vRkx4Hv3gv4GEwzCn
-S2tQZ43c/KJu9BsoW1E7p2/UHzPb1MYRmbNrD7UWPYGhWvDluxY5Lqgv5ubyq6La0em0nsY4n0QMoXij
-12Ltt9gMtbA7uNDLmPEa5PRKp3G9lc7GH2EzDW6SKNZVOWfS5C0RL8PZzgLs4Ri8eLSimlxgU17Srs9k
-YTNZcCPpzLYBTde30R9sm0RZvLWb9id0JKzi7JzJybeNBfAJDplDXDMbhT4BgLNtApUjrXobvdhdAHQY
-aApvAyJaL8eXvSh2hAMXiI0chJSlbwbi6CJQ76Oyd+FETmBzf/PeZtBHgPleRu/7JvLubaPSnwTjb/03
-vXOYFzktgd2Geqe/9Up1oX703yoq14a9b6R4L/HlVH4nUw+5UsuKwSJ6Z7HY61V3XW990xk7KxPunTJc
-r5IfNzk7Ry8YlV9z4NMnitn504/xn1BjHwvVNSUijDiZ04+ZvfEnET7RqN630Rkly8N/ktBrJvSchJ5J
-yNE5oS7tlDK1iXTmkrH57rW/I31wrD7iDdz7+NTzDPl8JgfMdyk8+nNDJ3sN/T8qdnv63+b7f7e//7dU
-y9bqv2F5e3vx7FiJshh0l9767LmZn3ofo8lng/dJcRZX6aVuLDSo6+L+y47LjIepzkjymWnhDu1/F0/z
-S7mi4JA5u3O1YTemEU8p178BNWQSCv24TVbpcouu3ybZAo84z7fpkj5v02y+2iwSegO1CXFRsl0X8fll
-vGX6c3sVFxlkTadnW+tnyjTxb2ig25pHUi6aMKD/joP729PjCfxBTU+v+rMeu4qSx6AXTOTpdHCxZZLo
-9Op+8FhPmZleVI1WmQo0y+/qjNRJBudUzZROrXWE+zJH7k9Qw/unx07/7yBfPU+uv0urpKBNrB4/2Q/5
-o6csYIvX+0/P8495SR3fn3Hjfz+u2RjMF/qxUgj+0sFiOXFkUuADJljvmCguzCw6md8mHwDUVNbU4++n
-JfFWZe8e0ckeX88ZlQtT7Z+UCy7j9c4c0rPsIdkWQOwBZBWB6S8zDn8oLsGMwAG69bQ63QyH8dCdDf/E
-3Maovr43k5//AqN3/ziVHNX0JWJMpiDpezz8eHRj6omguMw7s/qnrNvh9VSzmr0tOmxBf17DDdn908V9
-mZOL+8Fki9/THgYmmfZO+7MJ4kwCroTJVXnD0cmDh598+o/PPveoiC+piAENUXl6dTq491+0M3in/00T
-52/OnNKb/Rc+ZLL1jn+vJSB9xkTbs8cCfvvm6OgNsTxEjGdWLFuu4wxS2TdC1IAComgU5U30bqyjffwI
-sQ7mq7gsIZZ+oz72Hn8cMN3/KvrgrxsUZaGeEatgBzqZLMI5YPaO/jb6dDimohI6HpkfeRWYEl7Z6DEd
-jNS86JmwFSlNPKGFFK4EzhUE1QvFCI3AK5BqE/nEP0I9DYlS+s3vuOUB+saxP8U8OpgFBzT9PlpQwO/b
-A7xcEod/jG80avAr+zY6nvKcg8PZhBkf4vfbdypE5l1EF5Ozgb2vADXy4qcvvckFPX068aTd7Fk0RNA/
-TNCjs+LxKXzimxj2kb4cHD/GtZw8jIU4QyE8CHwtYu+BsJFmm8vy9Mw6wwsmx4Fi0dx5TWm+R6ddEal2
-9Wg0JFrtKng/vZpFF72PHx2v0sePVqkZ4u8+7l31MMaCh8miKuLt4RpGex/Ecx9WK3aK5CuT2tTHa2ap
-vvr7aMj3D+OvGOmT0x3wDQdm1Ve9kXzNnMuJsn1n9ix6P+31kJW17SW2z8zj3rNea7L23nBDwmc7I3+8
-kBuP8Rhn2+Wj3DpUfGTujSY5sRSPotW0moUYdrlOo4708YGPgTd3rCGDnTi9hDwDNOeJlaXUhSA7lEAs
-BUqiqOPKxhRObukbHpTytl3mFHjuBmIIj/MVX7EMgCy1L4nbCBtmJkRa8yb9fsoMkvbat5ymTCt+3WaG
-8iDwYKWerxJo+spqODrSQQOc4D6cFQHP0oDrHeDs2sTnyYFWjDn4e8mXd9zUrwFMeebwij9KJc+OjvZK
-P4O27Rm8Q5b3H+lLpmUw8TTL27+Mi7ebtRfaANwQ18LJr6dns91HtqCfa/L4bED7KP1NuOuWjCsnWKrg
-32SlxTEdTaCha3GNENSroOblhrQV2evHN4/eyQUge+CUS0DMOcPojI6OLmtuC/vGI080hc5i7TuNJW3z
-+s2QXpfOhvu5Mjn2H9DemfUi+z6qZ95iPxMIDgKINmykS8sA/Ttg136X7oHtn171Aur6iP4x6egzMXk/
-ICLyY5/ZgfvBx1v/9CXtQLSDfnxwbxR5907uPbj3kBa22bGm86ez6eo7OmCfzKblS/4XnXqS1+kZXCIs
-ktOz48BIui51yl8C4rhocx+pOXHsb3qjcRzyUp5zR9vLnhVxwTYJenxxdPTRQtz6MPTGQjONx9Ld/f6a
-3oYBr7qDmHuj11vv+C6LjsJCe7pUK43S/kbNe6PALjCs/Ln0oY16GXQHCKM+XhMziifi1WtY3SOPa/v2
-0TCIo3WNX9/vvx37zZh/89RbqkJQL9rE/T6mz5xXQhGMEtvamTNvew9gUnwejRgFi0i2i+ZMvPbAG180
-5Ltjiv7pTlhle2N4oc6pJDqSnrPVAUwTGnzu26BbNgo3P3W8BI3Z7ajZNeEQK6yH2j93zPfH9v08hgpL
-/X4R8/Fh31P6fuB8fxOrj9DF5vI75qViuPndLq/lWnZk1UVYiNO491GuCZGxoUii91Bzg9xtcA4nsuMf
-/VS9DyANAx2moSOvRMFwb9MMzJ65ys992kLZx8f87UT/hrDDR8FPWLZBRH/kvb1i/2+U16WnXkZe9WHt
-qX/iwK089WXkrTeZp/6g3xX9vqB0xdxT/xYnI7QPqv9EHUK3s2gK9SLvMOI/+EuTC5pF3t/xcoR/8odf
-8QfqRffxDy89/MF/fTz0RYOI/kWsWET/QlY6Uqxj9Ej+4Bv/4X/yB3+R+DE/8JN+lGd+gV7Sf9G/Kf37
-Hf8Q+Ds/8eONB5aC/uFly094/Ah6VB6vb/qF1BQ/+jqTHsWIFw+5B2/7/H0JHdnVBwBfZjQm2TzJl/Ri
-FO7ECwB+oSfsiTwKalB8ffL771tAaXps5ps+OqvvbdJgSV22JWaIDtl6e6X9M3r0ODwCZ4DN0zs9vTcC
-8g1FJv6ovO9Z6fXOD9R30fERxfo+On5EP8+j48f08ysRsB79JkTJQisE9xf/L3tX+5bGsuS/569oWW+c
-iahJdvfDgsqDMCo3CF5eYnKB+MwMIIi8RDDqOv7vW7+q7pkG8ST33i/37JNzItPT069V1dXV1V3VCF5x
-8ApBfzad42WAFz4UQi/X/GU8Q3iI8CSY88uIXlhexlbIJxGyfT/2GNr6tk/rn/2NnR2zfHyX29lpH1JU
-u90qFPONPD2Tb+12h/7R572c2WLh1ehhO7XVibZa32gC2YriqSDlvqO0sv+WDqgp3/bbVNdeOuTwhqmi
-tZfuckxwS9PGMH2BsMPaAFONfvJiDqqiG//gwXkyS9qMkIYyFKG6U2UM/3FEWwOeTVwUzLcUK97BMBX7
-7FFa4KCMdwGlgfyrYOIEdMEiS11Ncfh7gtveJ1dU4NUQJ5XVfDC9Xaj58GrS6yocoZ/2lRjKKPEEpGTX
-QYG8qBJ1N2FBbqKzsIr3xxQmbFQtcxwRfZW2S2fRVQ3HOBbPnokgSuFEmZrdDn/gCDosYnvhggqbUdup
-Yj5+LtsxkH5xsYUyhoQ32GvSz0tpiz8fK/9hOB0r2FgAHrh/wjwvx/5MYHRJMFtIEEad1MDwBsWq7uPE
-Hw9D+U6fqA1DDqDJ/dthb9JV4uuLJMlF7zIc9HAZ/t3CB6hxdorPrbJnkdniloDLVqw4kCdlCkAvCSq9
-2/iNvyx64xnK5P4RJPFAgYrN19SP4e3izr9R98D05YIIoHerhfPftPJTWsHc7lOrtOmNCh7hF4Tt/uaK
-mavSLBZ1ioHL3FSfsFzGK6EZRpqKED3CSgJiQ7joz9T8bgbQPE5CasQEhzalOdQq+D6HfQtRqAqAg+BR
-Me1QGiK+4ZgaYK7ep8A87LFtoOKjq4rNCYFUHH2VS1mubqd3M24rUyjceIPQ+iA+DqFLFJjCeAPnfZUY
-rajpnbUaYqsiagrf6DjHAy7dFRw9TycEi1vC3JxhNe/5Nz22FKFO31CBSqQlJbYC6g40csdEQvO67hmh
-2++DgJlaeIb/Ta+/Qq/dXnCHK8MI/4QOw37MYvGqtxBCnFMgPi7B8IVvflWawP/H4lGRICw3OdyaZnaH
-DPTu3XgGyBPMdQ1EQzF9UaMFjH3TIdw8ByqipONHhbOYajIlUuLeEbDNULjtUeHa4oiGQyCto9+bHoHq
-jrB+Q9TQU/f+ZOHDAlnj+sg7KVWUVyn+QwRCvJiGk2alBhsAHnXoBl17YO4Pl4bx6JbBw3v+yfDmMeKP
-g65P3ZpIP1lrQP1DqdLJW38oVysJlB/h218dM94bwB9uzvyH2n8zRPOpFwGR6UTTutUNQatGEyXrTeZ3
-tzGpTbTZmUbHMG6yRsI8vANC0F4ao33NnRaD3kTIbQ1q7vFR+vVPYYR7AHeKGv5zPxT66g8T6sVlagxh
-UC83Ryonydje7WFtV3p5g0PiVrfRJHZps4Kjnt30CQyE7pwbP91a1sN0XIo+clqddEv07N/2c53tvQ6n
-o5UKi3T3JA12oF04xL5IJ827Jiz2WdsmO+0d81lvFHOinCNptnkbqJ1bk+YvS0n+YlJ4otXfb/0l14nw
-c7ia8WE8awfctsOkiP32nhW/N4yzXM85k9yKspLvHVVNGZe/uVbmcK5zAw2vZLY/2XmHE6ihkR3JcrLZ
-8W3fJOu4FlK0towWR4PFOKUttdOpMV99n3rQr/ptfhPjT5DXnnesrZkF67fRDH/xI6X3NmM5Phe1t0Q9
-1N7KLe9aghhSusnf4ibLLt5uZody3GMbZS93uCnd9BcTKX9DnPG15wedaDpxrXwmmwWXu+84So+MrLzC
-kgPbKnMmtfitvdehqpwcpXHdmDBatC6jL9t7K+idTtr321odZnRh2y4twFbpYCmhVpRRwq0/TMjltbcO
-Aec11CEU8IeVr0u5Uvub15Ou1s+ko8nLHskG4XpQ7ElCDW9O+Huh9Xuh9U8ttH51dnoGxYXQ6PDPDHZp
-4cMDfh8RM15DhyzLMdQZcSkuQ27s+k2zvxd8f+oF3y9LdSvHhazBNP89DP78w4Do4DVuiSta/zUU/4vr
-jpcEunaJwY0N5gPwcv6ln3+NMv8dF7C/DI70mqOb1qj9gdvFHwGgNysQ+pNpJH4dIGuXoK0UDQ4sW2b8
-s27+//+nM1gHszf/ENBug99c/99Ae5haO1e/grPr+dJqLL+yEqMFnhwapUSvejg4za73bJD4r7GMN/qZ
-IN3L9J+zTWcYn6wZ7vrPawpZ8oBjHUtMdp8XBz912pC7Fper2x//+33GACr7PT649fatTrC/yH7f3jZm
-IVNtbTRLjsy9fWu9JIclpMHMwlJufF4iPJitPWyH5bIchAn2xEjZDQ9wY382sTsR8y0cZki87Wf9rH/g
-WxFsQ+fbpwapISlYrVpRrDhbiXsYz+BQz7c75v9Cx95Qz7piwBXfbL/RxcX4bPkiboIPPiFR7K9s5RJy
-nGt0s12Y+RCjk6u5V9LiEk50z9na3yhWC42v5x4xi6k6bx6VSwWVQjjwb1OH7ck+wnuHW66b/XSwsWdO
-dHV32R3caeOszLYh3CI/iURjU1/OztlRjwGM2z14dLr67lECNCdCc5cS+cvmcSN9JqqrjxqNtOGhj4tQ
-gCceh+7oYDkC3pEWvTrWwnFRmoxpoNOwnd3x9Cx5X/niaBdqdmH+wcYoikaGFuQEkW6zj/trDrrWgQFs
-d8/fQTXmyh49H6jGoQErERRi/K2l2ovOOxdp3uBcjPtMpcmRpK4xRQeBWl4ghGKXvUD46S5sni0LxuVG
-5TLmCC1uF8Wp8DF4Rpd4RpgOMjPwjTGPm9nBeNfHNa3j3cBGaRhj66eEKG1+n/X3Q8sDSHLUwofJkf2p
-5TNPGCWeOFx+femAgwcfhlTK7SZj62AkHjmMcTax87wpXBeT1kkIMAnJ0ggLl6+2Z3jpAZQO9WGx0M4B
-51DUuITRufatnmnihsbI5e3bwEksSYcHrRhqRHQaZPOjx4aAlflCiqaGn6SSs5Ppn6RiZgSP/zT93MCa
-cX+YwD85GalNKnH5+opVpbFJpE98d8KQT5lk5ThlkXCeZfZO1H998ESBl55KHUzeSOmijYCQ45oLcNmE
-NkszznMy/eGepXFAEzBfqv9wTuRB0sg8U9XXVtZ5VVamFcBt5ihtBJIyMf5TOb+auUvLCbBiL5yyyJB5
-SNMElm80aqWjy0r+zMuInjqJ/JwvNzn2B8cWqmdnXqWRKeCl6BXK+RpPdhnZCqHIT97Xi2qtmKnhpVxq
-eLV8OfNXvFSqhWrRy3xG+LycL1Uy/8vBZqXQkCkz4yGiXm3WCl7mnMPUiMpJJp9+wwfiTzKic8cLMehM
-/RkGi2/eiBsmnuPm32/+STdM57XdNUBzKPoFfJ1WS06rQWpZtCftW9V+yL9PdhZMpDb6IIGHD8Px/sKy
-ldmu+w6nUG2LNYraWrPbwMfnuISdHWOsE603zJFdID53xxnyxWKUL5fpj/AR5Sv0Vvka5ev0rxDlm43T
-aq30d0ZBdJQvfGqeRyyf02/jwvPoWfPyn+i3elH3oqNmmcJfowJlzhc9POnn1Ct8kt/zaqnSiArlKqLL
-zTpV6RWjQjVf9uoFiqqWy/kGP5tnlQgUVWrgcd7k2ArhPM8lkDBFgXocaOSPypwCMhYHPns1SkeNQ85a
-tU5pm7Wah8zyvCzyJ/3SKJ0tv1CRZ+dxDElwNbzUq7UIB7SO0LHiUaEQFT2CXrWAsoTo8TzON8sNepY9
-jiaAFqmDUbFU/4QfamShwQEaR9S1YlSsNtGBYq16HhWbZ2df8XseeWWqhlZCkVerlT+XIyokf04xXwre
-eYMeXoF/AB3vCxVXx6MRHXuNwml0XKIS6ad8nC80qN3H8ueVTirRcc2jpn1pxAEB4HGtehYdN4kcjjH0
-gPSTaqMandTyBLiTWpXQf5r/TOMuOq2Wi9TvT1GJ+tcoNb7GAcJeHL4k3AARpeOIaKZUKXpf6LdCwDQf
-KkQDda/AoWpUqkd/JRqJiFVEn6jpUdk7bkTl0iePfipepRqVq/liVGGCzJcjYhxMXZVqJaGnSrURVdAJ
-/FDNVfzjv7pHIKJOVc89+QEuhanw69+aXu0rh5ieGxz8clamJwODIEgMjFpfbWK0VInIonOPchN0iG9V
-IprEC6U6kp7XSmd5Koye+FirFvjHKzaJQkRyjIiYCQg1KpXotMg/jBQqpFo5Lp0gac07pk5VCl6dgueU
-S8ZijQi0yp9BRAXkaTRriP9cJWDVSienFEfjCYOWAheFarOCmIuTZqkIFNWahPB6/jP9EAzP8lGdyJUK
-qnt1dEBIHjCgPwmfNhvF6kUlqldprND4aICSC/Wo/pUAfyYZhI7Qi3rp7xQ4JUATXhtE1zR2K/xTzwtp
-UcNPTpCnRsSGAdSoe39rElabFXymX8JH1DzngSoPBg9VFHFlPAPV6VH7CpL8XPIuoot8qQFKv0DN9EMw
-ujjFSLgoNU6ji1pJCnHFaPd+p4P98mFsLScnj4l3b+90cmIV1+7CewQbxWkzuO2I/hKTuBe2cHpz00wD
-sLrrsLXmO/3hTj5Q/dYUgb3JzkocFcrR7/QmJE1htO7FrLbqrFc8A6uG3pWaq/Obu6vhxJrtbIez7E1W
-P3YWlCVxlKcc31VPvJCHV1sfPo270/GZPxnO0qpLUalL5CARnyYd9Z0i+OBD552zT51sX3S2SXrHNv5m
-1H6i/zfUXloFlOzpOa36+tlLqxmCatR7zKj3VLC/8DP0SdHHIX2hqFAeN/Qgad5SPThXlD6tBlSMaWrI
-hUkhPRVFyukpEnjVe4QlKCZPKkevGepHjoRdpFcZbtDl/a0/M/H8Qh94nlXorQmLxIt0VK5Ewb3bPENN
-TPMpKQreUSQtwjLqPq2k2Adq7WJMpfxIq7tZFzesqYV6zqor9fat8s3dAWFaUdee7CKTCkn+g37KGaDP
-tKSgVlGnB1kKX4aLyYJeTIjaJglocaNCF0kIzpRge3uYVY4x56G+9qmXgdsadpBbPRvtF4K+dmmuZGnc
-mGZUSoKEdhKO4kgdplhxh3rUg8aVPgQciOPzfVyiTIXgSbF6yZC/Ibik9AuuGE+BBhJy7BOy0WVQYquP
-hibfJgb/V0wkQjo+rsVWIyaQMdFPWl1TrOW2m8nhAyCvL1ywlRqgFiJXAuHTswD8GimvY6+OkvuDRIZY
-7yDfy7KHSzFo6LDV7cT3QGS5zax/exYF5BOrIJ2BkP2YHvHCQw3UvhrTY3ubsS+IH9GDkh8Sleekf+EN
-bqFl79WEWL6yiK8apdp8WpAMOi6aMNKVXxnfJCOX2sDDLWkVL2Lq2LfDcCMkDHdlz256i9SgPQ0sXt0Q
-ekBw8dVKzg1VoqnpitKDen37mgw9rBJc0ogm+g/Qv9j1FtOwuUBGxSmeZVCWiAXZJbzIigQxwDmXZo92
-rpUadQonSCs7p2GBKy0m+hoxaolO4OtOhrO5g73L7sgsEoX3kFFPPiZOk4lS6VuX+whSNW43Yk7Yz2p9
-tDMkOmB63HD6XB5fYzdoDbe3KXPKdBqujYR4+0gcuuqq9XF56AQyphhpPCK5e+LPWQXcLaL/W9vNs7py
-Da0uxyddyRoy2ugJKJaJIkiIQlO+0MUfEcWAZ4vQgHGYZrrfCKV/IzMeZlmenBIMEqVjFFsoFX7dpRpl
-GjKdQTkbzGO6zETRiKwKWsw1mTOCgzJT1nNRuAu+Put10ckJ+HYck/TQd67xRWq1+bELMJpKdWKUiiit
-fBeukXj4d5lE9DV9oXAnF8OP6u8tN4aA1bMawxwxpsgBJkCfL0EZ2EzWtwcBJblCMSEPOd/VMyHhikIt
-69PA7cS9HaFgu8dDF8mHrw3WIJ66peOBvVFMdUJiCOBbx5B+gO4FK2xYhkKYDIVgeRTgu8NF2XrqBEcM
-ytlrrMHwI26fCWgMBYIh46WH2ztlAo9p6rUu2ZOL5S2Yv690gwpmGl6OTKMmS6dsjSppXLjUOMKMY42L
-ECQdADmBPePnkswby5lfDKEQmZdLXDfSvosuOgTNhcgBz1MxMRGZTqBoybxChk4qpbaVH99EAMtJUdFq
-98A6/jCJv7Li2XJSx//Hf/6XlWErZX34H/qwyoaEa0EQW/hXGWW40pOKL3yj8OZHklrQExZXpiQB0fuw
-72xOpgtEX35wny4vDy4vzSS7CYl5F4Khs/khvfmRsPbMeUVE/IXSpQQWMp3Ly7SUkqVKiHkQTdDkP4fY
-hQQHdlpOg+DuSmNQs4MP6ctLag7XBaFvbWs2eRcmrTZZGfwHvd4UwXHzg5/4GqSGPmFfUK5RfLJaC72m
-wIHKSaG2pFS7WIxkKnI5r+Rj68YXbf5ACSAJ2S2NzcLXl4yUIqc/vYpRUe4ivWn2wfq6NzFm/5A4dFG7
-Mg64zLjQDRsUKcXrovhizxWZJ9CzGfHqeCLPLEvQgRai2a6a2eGqOJtbEk4CS7JlTrHKd3OqFXQwHRCD
-1jdkohoaRmPcHaXC7W09rOJ2XGPigCxpGhLIGq8fT0n916YkcxBpla31eF2D7NYmErYysbxvzw/hQGiD
-F6PxmtV1+B0GqYSjDwpMpkt/cE9ODymRXlNE9VvM5+jvGhM3td6XBZYrU2FPWPhVPImHIv6E8VYSJAXb
-uRDOIO+35x1eL7s5a8UsrcK57XnHzVHKzb2VRVDILegahPtOL5btxzzvoC2hzCIjCpihbj519QQUC/3Y
-JGQMGVf4BMiAuoXMhLu4cn7XGIOc+soGVnf4Ax225iWRnGLR+jXqsqqaLknj2AWJL/tLiYYjldZ8EAE0
-afNAPqQ1H8O2DQ89zQIRzDLr2ZT7b8y42+LpBa7jAD7bbBme04Q+xGLZcqPavoV2psMfbVP8vfZm+wkH
-zJ/hPOKZvz89HRAneH5eSvZEydiOl6g02mWrDscRHf9zJ2o/E722n133XY6IwHVZcT/fdnbxTm+vpUVS
-7Jw+S9UJ3cj6d8TjLhCxzeDyWqRlyOc0zbVGWD0zifWFXjauXX0yJNWcjCbT+4l15hnzIuA3EgHzOr6c
-F4KAkWVDls722vfGfX7gCvVuH5ihwqwtFq9AXgMmWAji0MWk0qhlAOLdJniniDwhqUgolZIFKGKC5JDA
-Ls3oh2rnA5MzMrO4knJQUsDFCL8SMuLhTwxOFgWh1g+ltJhnZSLWs2UAu5VbX5qbseLdlFlgoOQer+eJ
-4RCAZGaXtTY7IjcUD2dBKOC6dYNe8jzHPcVEkOoYCSaZRowog7GbetFkkuO24rM5WywL2x9FvjDTZCwf
-0Txkiu1bkSaul8R9NHFX6BX17iP3LsXIssbZM0dQ34xLx8tLEbssB6JG1g619o2ICnF8UshawASu5lKt
-74amdISPUYzZ23E7iScTu5LBi2WObzsO3uJRu7U0XOk/zQhSieSalDhPljH+z1mjv3TKJBBtDZ8sYY2N
-tXK0eKhV29iZmtomrIcFMkMe3teiJzWq1TTUQ6xN6okGAfCML5knWtzHerkn2iQMPwdr6im8bicT/Ybo
-rcxBuSxzjNEru+bv0MFYf2UukFI76gOUV4esExjs7LjUiWvooTBpjdzl3l2b3s1EI3LNXaP+jIWfjLnk
-K/uEQpc5il4hDqw1HhO7rJmthd/a1eOMU60Wq2fNGYNhjFpWSyP6GawRo94TnbwoDuMCH95r3sp4CeB7
-CyXjpU8vws54gR0QkJCpL8Dq2QrcQPS1PVbaURemzhiQvCa6HU9/9JbqtVQcnJYr1uvJ6xWNEQROh2vC
-PN7qGfVHL8t9N7ksECwVIKkGDBa0lnqrmTur6UdSksbVCI0ZcboNysQJRMWiHcG6ot8c6dqoJH3KsScy
-WNZ6B8PIru0VbxEsCRjMAjBYfRo8E4H4TcvvmAfBXCPAXA2qJcIJfzORvGi0S76DiGjpMBnNfiwa3uzO
-cLtpFgH0jxYMxLLM5gLlG7J+RPRpso8RYuWAsue8Ul/qx/3r6lJrGd51JZGoMpcKeHDsJUHIgqGuzagn
-EiUXcbbs67UEHENVBYwAqXKXp0O7xh/cZFuSZDWzRp4lJc4c31ll+Ak/x36Fvj7d4V0YsJ7X1g0h83hm
-pjjFZ7QUD4uC3MrKagy+k5V5LX2eI/8yrBfOi0YzoRqdt9Z22L+iebe3Q5xAlP6+yJoYp44oHl5cHPZ/
-AQAA//9SzO25h7UCAA==
+H4sIAAAAAAAC/+y9aZvbxrEo/Pn4V4CwMgREkMORvMSgMLyyllg52qKRk9zLofVgyCYHIxKgAXAWD5nf
+/lZVL2gADZJyfPLeD9fnRAMCvVRXV9fW1dXObB1P8iiJnZsoniY37v1X9jpjVpan0SS3B199dXxspWy1
+CCfMyi+ZNWWzKGbWkuWXydSapckSPv+6jlJ2lXlWNMNCKbOizArjux7Wzi/hB/x/zCYsy8L0zsoTK7xO
+oqm1TKbR7C6K59T0MoqjWcSm1jqesjSbJNBOGE+tq1/XDGplyTqdMGoyytvQXpJbqyTLoosFwybbU7Zg
+OWtTW6s0WbE0lxBU4QZwUpbl0MPUcgDomzCz2G2U5QCLa4WznKVUywzJIrpIcRxYK2VzqAZDnn51HaZW
+kkbzKA4Xz3l3gcXR2uPdD76CvpxyGbdcBKrIKYERrhzXure2MA3Y+BtA0Ps0mUULAC+wHFUQS31lwX/l
+ucM3WO+BR4/43yf1BG3K78kKW8mKUpMkzkMAJi2/SpOFVihfrhbPwsklgny/Ld7PWA4vp6+mGXwYjSsf
+AMHqiwVzkyXWDQPauIYhrdaLhQWoiK0QJ5CPM7+JJky1EV6Ft2d5mOYfoyUrml6E6Rzm8xkAyeJ8tl68
+B/Bz01gvw+x1MgkXZzD54byEboVFGh3Mb/EL/0tZvk5jq73QqretKBbzZx0diadRucjYagWBFcPYBqq9
+rTUJARmWw1xzL7NwkTGtPD1ttXHMWf53IE2Am03/m92VxvGZ3b1PgZpu9cZFw+qb1bHa3Tb8K2a/d82b
+G9S6ysJrZm7fg++LdWkMSOCtCpIdF6mYAzCAsajCMP+TBUMKXEwRsgwwmF9yjrESPUCDwEkKIkpSy0Go
+IgCpP4A/Tywd3b0Fi+f5JXzodKq4RdicUmHo04lca7Ox2m23B7PHbt/NdPydWt2TajNEcHorKVsm1+xV
+zpam1t1BqfZWm1QdEYRl4jd8/GI6vjL1OKrMvgbxGNBCk1Kfx0USTvfTCYCCOKbugGtee1a4WAB5szC7
+sy7uViFwXGDYyQxIGNb5nolH1Ar+hi1iv71L6FPhur1cxQm1FLQVuiWx0qLR8STeH4qLOg6IB31kwLrC
+nGUlbGTrCQooHRdY4zMtrnYu67S90nQS6IhWxC727Q6+KuGEF6jS0AOnfZFM74DowtWKxVNZrEwrAiTH
+rXIC/I8Bh6i22gNkOHJBr0JYS7DKo3iyWE9Z1kOOPbwODIve0/AwDfPQRPI4mKZvBCwQMCLAs6jUwFio
+Nu4dZQ2jr2OBfrl7OOWzRQRi4T1LgbKXYTzR2ZljYJKCYld6Bc7CrSH/4xvKlKkN1tEN8XhcTa+5fLIK
+AWWRhLLgRxox0JxuLiMoG4KikcSLO9CPwmgRcs1GNhda2gjeXWQshdkbkJ6yCKFxWACoqiVpzkjD4R+o
+40LU82qvjfKyURjS2hYDNsDgSt6+Q3rG7MYEvqbDLECPMtEWgi3QhMsMSiGVv+BvTMRBvA4REsh6I/FX
+CAera52M6/UWTUjBxoDJI29GtQN5Gr3CJV96kUnNpMLy3Z7Au3Nv5Xcr5oMWwTvrTlRv3RV21/asi/Vs
+hgqlD2hcszJ163pDhdaBQt6G19GcmKwFUCCbfg2K1cJ65Aly42+9ZnoELVc29oFxjVs2pUgVtN6/nr17
+64GyyxcDkMc6ztYrTnr1hccbyP6aAVxNJIYVcC1BAdOC1ecZqZEXFWsS0N/CFxpd/Hj3EfCMXxDfIKwQ
+YqzQRhGLiva07ZbEzFclUOICk4FlbNtpF2Xa7qg/LgPYKr7u6geskPUCaayyWlRlX3vu5QkNY1h/B7jU
+S3oVnZLPZOaD1l3Q0qCkftBytPLSXBM/QjsrZmwKTAVtoGUIaoBgLKoBbnqJ0fQ06HiznAC0oT/oMZB3
+TgNi+UJwdaEUeRYzqXMMOlsSd27PotS0nlwJFX2vL25mWrXbCrmZWYNqegfnMH04GBFy4vbjAmfwc7SS
+ti+ZTmAjoy2F5jnAkDXgTmliutoAmhho13W+LqgJx6yIqrdaZ5dOnW9j477FO/Hq2kQc5VEIKtxH4ocI
+hvaiXj5mt/lPyQrM3zyZJAtquPyqXkdNrK/Pcr3cdJ2KtcZ68rleKk/DOAPGfBb9Rg3qvw1tskkCS+ZH
+UHZkhcqrZhVGexacA5d4D+36eB7N7hw+BW5N4VhGC5CP0E0MJjao6cC8NUZGGND484SY7FvQ7f9Ipgxm
+MFFznNwoxidfOPWRoV7wHPRqx0X6x/kBCLqVtgRXGuq/NC5DDgHgf323rvDzQZ7lbKU5HfJk1V2QZOSf
+ATX4HTndHSOvksQTqfjrFIS/GD62ZHG5ozlHik5wBMk6twKd22OX8D6zZjFKz1DvltbJlP71ABsZKW8x
+2rz8c7qOYxx8lINNO5PNzWKBwIz7SpYw7R6tfqqE2kgGQjmPQDpDPyzPFywrLHo+iGLSee+zuDr5iLN7
+UCtwCfEyhOs3Gp35BR05wKaeXUaLKaALRY21La+LVYh4hCZrGPUMHGbwVVkDnDaSKa313nOxcnXgsK8C
+OqCrrFcbwKDGGFscUNcCxrlmBZRlQ0zj4o4Y2VAMsSexYPk6bbicVWZaKybaygY7vVBCYZjFjnuIQ8nU
+A4eyPHA0xirKdH6ZouFvMj4PaRVRKcCFhSz0MCE9gFJjLrXFjLZd40CpoAOweQhgacAG+7cyhm2V2eiE
+pTOJ6jzvNoTqq901c4CMMzT44SBL0TrwrG/7/Tr/xlLAJEAUZuhUKDGnPKHlLRAvhTyteRzYDMZ2CRwm
+Kmy9Sp+Ng9rBvUrjFgoCqdtSWRDgvJruU7Vr6goyWy7zFyAMp8h4S0V2rb1iSgcmbxoOfpqQLx0ZPvoZ
+kIPyXxHykRr4g6pGNtEnq6R7ZdXBOE28B3CgUEDCi8PhE3AGZqAL/rLcElUrE4LNCJO2UNW0gaCzvKKY
+rVNQnaoOIt5Xl+hsGE3JQcRiVFZ+/vDqWbJcQT9x7kRTt8yn0XfjV3UTGlu5nDB53787+1j1nnHFmOuA
+7XC1WkTcSXh8BcZipTCQPPqDnlOv5CD3TN4yf4eUKNaw3OEw67iN+NuJR85eqh7CMrbugQB9+B+QZbJa
+r3zrpCol9fICMQZk7MftDrRge01uPIkiLGO1Asu+jKZTFtuudbHOc1C2LkGUUgNmr9227qxr9oC7DR7a
+DxyTJZYVTWu+2UlVI/XEK+FxkD/BRLhAzwWs3itAvEf4j6a32mqhfQVtTwF627mVgFwEyoyisdZIsXDL
+vsYyMy1KCTB3lOCQl7VJfZaEU2753ug6q7QBpXqCRk1+M0LBFUfBFaBAr61wcVXHham30dW4N1Xal/au
+0PL3eXXxP4R4tkA+WaOhGipwSgycHaFtQEyBfpOrygShaXKbLCMj46k30OJz24RU3O2m7fSL9Xx+R3u7
+KwtFtFwYmbEarY5kdUf6u+5Nut96wsmDzyUttlpfWF0BisTbHHUrrFwbgbDH3IaWiKpWaNKIcnv4jrDu
+LsPs3U38XmzjOysXdcjWg16UvZSOe15ytBq7u9rkYmZ111PFYUTqedBYb/vV4W+NM9vTPYDN8BFsmscs
+ZdMoZZP8WbLmttKOdsuFB18AsYmQEZKGSQQqvAwnnylyYwqKKRgGSyawmDWiRDAnUVrtj4sXyn2eNaOH
+yJhKU8EinqLahOM2zSTNTdEEdwOSGw5/75yZBkof2S+xjcKlzmykqTegBfRSmIjpjv6sh9ZJX6n+h87W
+//wonlLIy2vcIT58QFTptdwE2TuyQ9WFMqPndh6OvxT7IfkmklVJzFV+yy2fU3Rn3h/E0O8LBmlVnEx+
+OQBFc1ltdX+6MVrFKOUNcgx4XPw0TcM7B7WUIprGBZHcx+EZCvCoGiphVHmnt6hKFSW57QEKPW6FGdb8
+/7TuW0O7v1eX83epdn5F0ftP6dYl+8XW7Zfb7s3NTRfH0gV8cWtqOgBmFqYZy4OfP77s/tk2N1qyc3AL
+0DOV+qN0/YLAFFEMvtAw2C18DzMbdojcJtQvV7jptM/cM0Sf9TKcJwZDvfWskz/CjqlZMWIOa1ue1dnA
+372fwuz5mpMOe7bO8mRZaKaVQDBZ/sBiH9PoOgoXu8vpbQG3IrPrfmss9DqKP+Pn2qvNpl7lYzhXZenZ
+VOgNy0NcjbKg+m0q/IHNgc9k5IUK6q+gymhcR8LuWpqAqMLPkVfxJ0vGl9e/VqFNkrz3npyiHLmvprJ/
+3eGk78JOI9r6R6cfS7lnLM1A9YqnC4ZMC+SbKrIoguKym4icv8IBR12c8d2emjs4BGnajtfLC5a2/RqN
+61VRYMndmVKT9QVzkbLw88DQEfcLGTqCwfbevvjYzqy/htfh2QTMmvxMjRmGj9jmIw4z65iAOHnc/3P/
+0TePHz3qf/ete2wM/AhRMALkx93h+bRzDJYLm+yDnpQLrCdpAezIkyZe0oShFTL2V3HOWxr1x+5BFm4F
+c5o+IFgIJx0aglfQlUebXeVASs1ZjPv22SXu/vKIwtBKoRIG7eWXLEpxX5jMQCZCuKu7hJW1T01jt0bH
+sFaG+ytBR6m+U27KMlL5ZhTu3gXWqxgd3/mdJ/Z6+oNKbBv5ZasN73POqn1gqdACNh164Zk2gwxzxqHh
+dUEnor0IQ01Qgsx+4KrUqPyuY7g+gFegNgm+QWG7fK4NOgnfqWs3fjfs3nFc1EqaRuITLrpNVYqtv+ok
+lREwMHl162Re4MSz+jsj/EpN1MSt9Hd41pStyiQofA1yrP+I8stknctxVBh/pbCZ8ctC2BPUoR5rXw3y
+W3wpvx7yADC/KrWLZhrVhproNPRpfo/7OGYwK4GtEmSBrerCMwVqV6rscrBq45TFR9G4Lk5FGV2g7qKs
+epOCLGBdmTTBL6CQbmACeDe5VNRI055mpU3axRo0xJBPEx47zg+wZOuLPA0neKYF7A6orgJdsiopvZKa
+Yp3Odw35yRdoQyZttOFDQYIKrgba08nTbUDcHu22RKsTXhhDB5XDcncvykt1wIIeFc2PBztaQTCNckBn
+zL7VNxtG5AuEr3Xlw9znVPIQE2aaVnJpuPvWsQlHFAWi4yZq8MXqpb5o+ReVEZ+Knq1OuecDVqihNUJy
+pzNodgfrXTxLlkvQ4c9IGUb1qMD5qLngeKdbT6/2qhAEiJN0zZqN7H3CQyfSvY3tNV+bqxuZXTkq7xAc
+7e7jAAW8iU1U8FDMvdl+aJlNab2VnRbFvv6b8bSXIexkCg2MYfvVl0NpWGKllTf4PW3ybZByg4bdjgOF
+6A4tqa5Wioh/cfBnpxtHc/dUnVwi9OiB8wCF5NKRB4mc9tcyUFd20eaar+satsVzExg8JC/ZfW6RakNl
+XCnyyOYIa45R0DrVd4H1qadgfEBduL3LfLlw3KpFm/Q+ZWyB0aHJoNYlVhFdOokxdoeKHBTCBhpGssDd
+nrljszQFeYTnBH3LBq2N4r87ls1/sZ2GQuGK3DmbpcD8MhXwsuV1Jg8O0xaaCCvpgS07ddo9OcVd3t4I
+XVtd8bIbTQMbY200084etyut34TZ3yN+yDlQXdWaJ4c3HmHMnLZ/zcu3dQ+BCKcL8zx12hUoBN0BCBWf
+QsqEd1hUB2s7W+MxJalilpwON3jwm8fOeqUz43i6M4kxOC226G1Jl1NjEi4CI257oj3URsvhz+ooHEld
+cUrZNYUSRnHG0vxHBvoMK0qWWzHV4yfVPiZOETRUOcXB6YqIRRw1KM8O/16dWZqz5kpiSsszInq6AV3F
+QrhQK4uB7FKASh6exTNjOajyvCUkTKn3g+0OM8b3cdE9BKYPDGhRmAO8+R4VKh8vF5+e0Rf+LLZZ3AH5
+NEqHSZYhnuSWvQqfVDzlEYzJYr2MObDhfJ6yObI1ZZqQ74pvLVgXd3huP8SYTXEKNU/mcxxSwe/wBfuJ
+yjsCnhIsi2h+mV8kt6TQQ/d4hD9ixZCpTg35olQXsQTLyoQQUYQ2Nh44aHe5niVJs4aSBQidHMa+1o6l
+S4rdxTGgc+WglsbWMrz9CIYdyz4m2LuJ2D/QkeRDWqftXEdn7YIIcOjVCP+CGbkccTT6CjnUZZc2RyXG
+yytUOG+uLFHz1PDWuqJYdVHxr8+IxrJ9bXAy63KKzGpN8R7+Eq6KhniMs+Pugao7h0rtKpvgZV6Vz1nG
+yZSZ3KJA5Z8x9oYXqGt2nxdhxg9Exp97yNCdtoBhgl/ahiowXPaR3eblWvi2m8NrUxUkgnoVfCur0GkE
+iiSY4KInznTByE1cV5QzRQTUGjYBa6kVKNAqe9HyXHyyTmWwsIWVKBCFGEKKmSNynSMojVzCbnQJV8bF
+ITGcCTUN2lPVd0elmUkGJT5NntvjE+ZItFRaKwBTeBsWc+jrQNS7fY+IifOfkjT6DZnA4mwC4m7h6Gu0
+eoyhtHZ64XTqCHJ29R+4Howc0bAOX+WcMZZjnHW+CDPF2RrGuGUsx0OlSEc44yK+iCSC6Nyz5jDzlyzk
+hxFJiAFXX5a4lOSThCDBTdCnxB/16UziLoYQtd1m8AUGTKcKDOAb4cPgDxJ3E+FEa4RWetlMQT0FSPo0
+HaDvkswu8Zwywy4nf7hhbUS3kLPcn8jLH1Px8okvovCy0lmFm8oA/TKjnNib0wDHIdp+j/WynWo2D/xQ
+sBhO8eH+148ECLZUarmHz0hxJt2tzplwKdI5rQRzHvFRZQ1D17v1yr0aWr4gHZWoRyZHwuMMN4yrb9rZ
+NNVLoyBu2DKRdQ6jC6ESABd4hpzLaVfQ00U383XZ4uBdsPw50HJMW+01+CpKmNQ4vvpiTlYfmt7vYWPk
+EhoVcjFaMGUiniGih++71oknDulEPD2TEEQ4PVIvN88QD1r8iaEqSjJdJNvqXdIrp0KnoN+psqWqXYsD
+8k2fIFmGn4FI1im3srg5MU1YFrdzi0fsAiUtOIe0ZmCFlWVk9mMCQC+1mAZu674XA1Qng+0LKmiTpO6e
+DIjxZZw18CqUEYbr17wsZdWKE3E8CQsD4IU675bd+RIUkx50IYGsYw0wIaYqmc2A+aqJkm/XYKP+JAt3
+tBYyIqGPmF+LMDkHwSM6SmaiukF7ec1m+QHoWkAxhayv6p4RRcmyKp4l4I0PLV4bxLudIuD2oL7Wa5D1
+JrAm7602H0LbF2PxrDaQUpejC94WdLV1zW3UQfNKyPxHNAX7GxH/2CUyQCvDQohRbhPAUCHMGBr8yKZn
+0fwwNr97YDCtAD/8++VDMk/WHz6sGgdqYFv77R+pJCgW0K8xSlkECJ6CIsqGaaWBDi4d0sLUwqmYp6JR
+juoVMHlYzt1UYLjS2qkordbgELgRUOtJ33iaR0nAL5IzPKHWXlFThh/FsWMAQTeIS1AIW72C/gs68WDy
+BVzMqwqF+NLswxEFdGCJAwCof1N1L+YVDQp3iERN/GLLL3bFGteaqXLOi7lAYtN5WJHDQS+yLR2Ilb6N
+EsrQJFFODv1gDc01Bhk/rmxCAMfVeXf546Um5XSu/sh6CK39flzr+myyCuELrN7J5zkFsxcHJJ32k2l0
+bZG9HNiG+baPT1Wmqo+JSl0lVopiQg+caTJZL/HIeDGKrVvzoJTCtaC0SDIpR4YGA2gsKM8XCe7Z8+Bi
+siyId2hahSQPAUqJJ15KhlhGxCUa0tLxh1bzXY7xsFLZRNUljEkShoWOg3u/pD2QhU/pL2sw1NQ2ST0E
+9Ks4T/4esRtBO+Kbeqh6Du+AVd7CAplfkuNON+xXKcvzu/egbOSmdW7s1UC9CoKbyzD/mPDyVS5A5/vI
+AJ8skoxludPOU6SFwn/OrVN0n1eoesIWC6RTiR9OqHk6Klfjvv9IuP2tfFqlXEELgP47NMlgIV3DiFQJ
+HXxNoWl4jfoPASYdfgb1tl+NKVTTIJ23mJhyyhcTkpLMZIpMc5IsknSAIShRjtZ7xhbXjI6tr7gkJFMJ
+ZScoyHlm3RyLdKq9n1+hlOVGVIRB3biShDMYtLEPLIvm7Yx3YK0Wa+jyqxKuDUKwpnRhQUtJQpNvTAz1
+J4ZsrP31S/jvxx/bO0p+mF/w438f/vKjo9c3NC8xVaqDMNEKbhfcqUvDBCu63gYeNMSPz6PZrETXM0yT
+ueMAYTgNV7kM9fxrchkbcQqMI89X/vEx/5n1xPRMkuUx8MYrNsmPqbyxG7Glp8IkVayliosFMJHmgLU7
+GjZG0RhIT8cpvHGRWqvvPOvRt9+65VhAkxdL+TEoaxV5CuNoiXsMGR5DE8a6OFhI9BnN53ddomlhw1B+
+FIlfD3PUvgI9KAFGYN0law9lyiTK71q1ZS/5sSgAzBhsxvrs+mVyM6muAuZKY9bWAwWvyFf0qN8HUxDB
+9Usu5RvPmt02UQTMA4NF1svyuwUbadARdbVxC9hO5xcO7qeOdKIjQuvDFNRenphePnLHvasESMD2bJxQ
+27UPCTQwpULScmRQH5i4TqSuLA7CcvpWmH0JnEpW+vHO+nERRphCGfczF0uQePKboHpB7WBJrtZ5bxIe
+lxo6lqXfIzlbPKw9AzmdfEYZimKSr6V8DQwss0ZAq57431jPiAfrvrR2qZZ587nMi59dMiBQzFVNDrpw
+kbJwigZ1SH46vp0Wi/h3zM6KDWeVvSaEEA/I4UMPt9XzdD3J4WUQWHSkrPhahMI/VnmcOI8vgfUaMEDD
+R4qJ10tP/M81pYEJrGModu6cZw+dUb/7w/j+xHu8deGnd9Crc1cE9HOsKbhGismIbfYTZBfVl49MLx+P
+3fHuEf3JU/8cNqiOM/TPe/zRHbrnfyoN5su/7h71y0US6uMG/vqoB4Rn1b8+2vn1sfrahJCvw/7FyeRR
+AxK+dkZhd/a0+5Km7NHW3ff74LkE/vKdcT4bPjzmHxqHMZvNDhmD2/zjYNCB7e0fRlFox5CKQnuGB8QY
+OiAVxP+7uIQpV51IUgV65Fk4C9PIetxMzuG51kQTAXIWM2prrbfHg1q25uLESGlvArTi58JseoH6YXOC
+It2+uiBlmkcxfGZ361VbPwHCatke5AUEl2TFZSjhyfahdON5mnxmHim30sZGa2uShr/dWewWK0TXpHm/
+emE9+aHaMNajqAKsE8oEbNMoWy3COz9Gl/2Ec22w4NazWTTBaJleTTeVgSAPTBE98Csv5cnV8mNLvw5q
+HbbolxyPIMGxe7vi6CkSnJHHxHwmx5RcybC5WYp7Ef77HdsndXW2MOiF/0kaWsYghQZbv97sl/hvuFf3
+RTZ5j6cBKRkoHqGj5FWcuFAesh5PzgxvH31v3lV/L2bQkJVIFin8PeI0R21Wan4g08yUm9KAx1g+DXxa
+IW2RNEPsl2WOQMWoDywECmN20tytFqLpKBdpPPlair46ZDewirEvHYEBuGoRTnL1Muo9eubVl73n8Xd4
+2Ro9bVUXie6b3NPV+6pP9PB9VHNypeo+M98+PrtM0nyyptR8LfMnsONAWXaOf3kLrOTBceTWk9hX+TIs
+Gry/o+1Z5iYLXu0Yk1Y8qK9+WOQyTmLHSbq6Ex7DRV6C5Kl5gUoiQgavkPki73fBmFWVWI+fn8StNBih
+OIqr6dXlCwWchm5EV+pSExklI5PDidi0GSa0WOGlISCwQL2vBHaVAlFlW+6e+MRDhEyl+O6YOoMrrhaz
+Q6lnlV9TM1foUKpnXa2zvOJd4q4q6waxTksfrZrQsk+s7NeFTb44Q3DNl8ftNa3RirdSRfQ9MMsdnfh3
+0aXBUYmU+UyEn1ZsQZWU7r45REQGrtaDpGWLTY5tWcQ+fQLqWlz7vozi7jK8tU+XT46xQEMxurLFPp3I
+QsfQV8lP3kiHJVwqgHtVAA6cuCIKRETEVPaLoLW2YWq+MrdxmVxX1SzjQQ+1lxZmxg6bvC6yXpViizFX
+twsaEuKU1Yv/PwE2STUDwF9KBURfX0wDjQGuZum8PTT+qOjBEP0SJ2oA7b3SiCT7bnG083oOp3q1jGQL
+4Tqnjft6tgc0UoCpyih+FRGecQk0T1Dy4IVt5iHv4CVCOJt3yOoSQb+6rPeBJNkrcS0NYCBjuCFASrYI
+Zc8TKXojjFoXYSNoSK2hr5T25vdNUwGsrd14U44DqINa5KQV0ep8esu6msbBm7kdD6YDNIuRCJTRWQvr
+OgpBugmnIx3P6YozFTeMdBGK45d6hSdaoomkTzIalhpDJzYQF50Pisp42amfGPYKsOtliDfakSJSqCwC
++q+MeXVEvk5FkdHetAclsYb5BEQIrM6RK+xl5zI9AIxKhuArVOrvnl6Ft89EKqHSugRr/vYy9Sh3uung
+Lw4ACjSgEXj5eoHhVyWfrHAbo10y71kju9//8+SbP5887v5wMf2++803j6fdHx5//133h2++OWGTb6bf
+hX+e2J598t1s9vj77y+6f774YdL9ZvKo3w2/v/i2+8P3k+kPs/B7xsLv7bHxcC/vkd+4B9BiwjJA0gqQ
+w34ChQwkXvufXX1xdqFsuyFHimps1yHfiCdL0FJBt0qpoK0hzxhMHi69Sd9i1+FCe2M+01ma6MhYbNtk
+EZU9ZvoOUZ6HsLAw6BgTn6nkUlLfl2F+PIoBtHOpfk/xFE47cyl5okdJrXmsOiygGMOV40TtedaudEN0
+8RYfFJkNOVmWIeXLXnSp+Ag6+3BPlUMMfL7s7Zctq7yLmsZqfEk53+RaqE7x7tJOfTG5jdlqeXI5SkVi
+IiRzT1S84gWrpddTd0kMdibrqqNWopRz4Kdn762nZqQKunbO7jK3SthFknv83PsHu3iZpMuDy/XeA7/9
+wC9reRPG8COtVjWI978IURHFwEXR6oJm6w3VfY71MoDAPeAg93gl+qmZfYbiIIk/gegTb7XJy0ggesAY
+zQkVeEKm+U4+kwo2BmBjUYTtk3zXlA1UuLmpTKnCU3ndmkNLQ5X5dLtc/JTnKzGEfcl6TVzXANzv58Jf
+xI3/k1z5C7jznmx/X3TXn76ScU/Xepqtem8ZP4uEG9vm1Sto/NOLWzZZ5+yZKExbJbYk01piw4Za5Zt4
+ZcfvLjBgokE7wKsrWRE9I2sbJbgqFTQBYMjfKW+R/QKw9CiVavEdSaJ1Ui/X6sHaEevm3yP4g4n9P0Xo
+BxK5iZQrtOw0UnO4yBKjcHpxm//1TF3q5dHxX0y1F5F1dMHQbbbOtFBBnfBf4Gm4ZnEEn3so+A4p08Mz
+83vFkyxNF4KjX3AOuoGIqQH9i/sBRWCiUMJyjCDLMKMsDVGqYm2LoshKHWiwOLXyuq95jy4vLL9ZSlcx
+kZK1XuHBsAyv6mgLEC+JcjNz+g9ony5GMSrZTUTbdPWEIVfy/1Pmd7F/ncbfJMnHhBykzUQs+NJuApbM
+a4UXzGFNVGj4VnUlM3ISP6tlozUY2NU5RP9a79+YyL3T+D89hXunb9u4bdgsxzHBvLhMlEycp/F8vQjT
+v57JS3KBu/HLbS/CLJpY/3zzWlfREi58DKZQL+Qt6YRQrrybHhCbnzKeDrJcTyMR/F4SlztL6h6HjFRl
+ynKBZo/xrmWic6SaJKb9FNDFczYBI3XOmvRoOVKqtgSaUhtHlTuatBuc6oV7GMT7ydTr7oueimOXlQYp
+z3pDo77VMMbG6yqaymvoTeIP+BUTCLFn9FVH9t5bKbCDVDWAo/7mEL27ttz/bdX/i9X//7QJ8IVmQLMp
+0CAJa9NyCJ0qy20nHEJtPrhVdHwv7ggKMmbJX9GoDu53VP1XKZxCEDXqIf+Zxc17+gMWNDakFjH+2Ldw
+qUxpseK1EtUF+l//9V//3pqCBmpLB979T6wQ0axhIcCX30vVhKY/hJKxpT+aek0pvkheHtBRk3uOQvYZ
+PxKSWTdhTCeuL1SGIDyOyVLcMABNfcJNBC2dUS3GsHpwSICpbcHhxsquOwWIAildOeb3FY3T3c0cyh/v
+Xk2dNuK8qy5iNux9tEQbaDPwR2zjaQ4kdLFG92vtPj/aOsVM1PpW2oNyy5+q3z9VGpB3OJZ8Fk2qo4yw
+DywDhOIUlChjklgUdRJSHuTm+ligbXIw8ISh8nbJXU2IUnQSq6EhvrKbm4ia7SS6dk40EIm7I3Kn7TX2
+tSoOde8YtijU2EolSmtXWyLtTybKtpvuHmtuAY8P5pRRqu26jcagSjvF73rl8ckHNvrFIMlESvqV1ruA
+y42XNRwAZkNHvxfgtkv3frYxKSk8lxNamTOV7m5Xnr9uc8mstTspUkL/roZV4EKt4SKU6Pc0XIQj1Jsu
+vv3OxmmvWCTuqjev7SSr9puco2a9JsI09/BPw9U8IaadxH/N3wU39OWD1+D20UMQfMUxGu7vLLIY+SoN
+klmZqq8B3/SyuR89/1Bx9N9cvpKFzi9e7GhfUJavaKzhch/J+P3i0VyyIChfe27AT4mn+pXfDVAXFOXr
+P/b6qRxTEOY0eQXqxc6LzOXmdBF9ygMhs8swxcSaKkbU4wcPMDiHgnkoVWZzgFQ19JN7lbvYXJPoK0Ix
+mk8KVFwsi4Tff9W7TNmsyIhyJKgwOLHdpqvYKipKKXsXX8tfciOjFv172FWjO7JRl4K3Dgh4M0z8TRjl
+9TtN6L5buu27fjWTIhk2w1ipEtU0xvrxxprvNNZmaqXdeVdczbiq3RO48yNdwEia9YuYrl+lC+posE/o
+OsDGmdbuRlcj9MQRdFN5arMTYAkD/nfNnkJwc55wnODfN6/RgROzP1RPb3WdLrQ0Q+KKPTsSAXN43Gd4
+HehBbULcNO+fKUNlkrIwZ2d49PfskrF810psrOQAgO7vTOsOTAg3UlTUoIMRhpTsMmWLwKZDyRl2YpMl
+HtiYAfEYhmxbyFN41gREEKZNsDD80P39yd85P67FhtYCVhWJ6l5rT8ucuFwt/N2JuXWrXMbwy2LmRiuX
+Ev94x2/30TZ1ta8eJcKuHTow3t2gVesdeIVDccNtuR5ecYJg4dqXAOzwRzQ0cXgIl66/iduOOBKLLPi2
+7Vnisk7b1v0WOm5TEYIKkrxk7deuCCofycbMEO3BXgzza3+acCmaAmbWPoovstWgPdg/Wnkk3DQYUg1+
+TksUGE0biK/h4s5hNA14PpKDiDFrosPMnMs2yysX1yi7zXQ8sdRgTzUsj9lWr1zfR+TZoVRe3LdcqWik
+0Yptx9cBXoz5PFlackPQpt2m4pt0VFbf164UNtxFpPWlrlp4Yj2ie6L4J9+kReB/OAP8fs/apwadJzvM
+5ohpDfKBNNy1qlZmAfYOXRuLnZXuoGsKITYcoCtPHuG0EkRvmvYCOff6uPlhTjFCMUFFa3yugNcU4+ue
+eHIM+wHZEaFoHo35UMDvHo9o7neOyAzMvikiwLKkFMgZetaFdqQ55NHgmMSRP9VDOUVJbGwPr4LFlqzT
+CfvDuFXK0befZ6me/x2upRrZx7fSGt9SVY2ci+7MCKyUVq28WsE5/mUUdn8bd/zz4/Pj0S/nx+POsQfy
+zt3FTvZyj7TEq3YxEYJqsxFgec13T+8qwO9sTnuv6HxEnqR4jcw+xpQexpjSnoo2bigHij0W+4gJEUBj
+PIPfX3CN8MHErV9jo5M2XvK5U3kZjeuAh9Ppx0RsUZU8E9zE22X0H3pBWo3kD7hnrbGR/ZvuX34X2+6Y
+1tJC9UrN/87b0Mq+rj23ou1t4fjY+unps//2KXQmimeJFV6AYc2zAvOkH6L5PLEwV5qlowJD8X5+Rcn/
+uFb8RRCXrmTjloDE91vilF/UGIa0ElUEGonsb0NkhCSGpLf3b4QdfEEwwgGXYe7j+194MWbT8nXqF1D+
+EQHYhgPeJKN5plgr5JRGNwzjSe6UTTD9n4WZAL5qBJV41cB0305JQZD6QZMXPzTcxIu6Q+3tnoPAnE3i
+SVElrjS3DrLjQi+qWdmygZjdvDYrD00TT4K0MtvN0Zg5W1JayixvvC6RnD0CTqFKnVJF/mNfnJO5qnQr
+7qZEMXq+DLHenuWH7uUo3nW9YPMCLfV1LwV0MU5PuP18qzKibaO3aD86hSfxyaFI2VF572T834HQCvQF
+VjUM7EDpAUiWOaJ494O9DtfiUl9W1lYwSMQT4q4poGFaLG01REqFWOMWxUgdcwG8aw4/GG/8NkXLUOk0
+mlwqST+S4IzNjnohuvecOaJ2RdFAjH+wr2QZkDLfc8zlNPZ3UMA6Xe2GLe2TiSa2WKp4qDTUSaPcNV3z
+jG9+p6t4W0t/ovWU0g3lNEUGQYlRzPIAx8Ud3QWzV+YZjOJDhZxZsE1ZzlKYRPbumqWLcKWLtnm4wlOq
+U9a0aBJVp3+YTMPGNMI5/HbgVBBxpYGdsk7UUvIKhnMAZ75IWfh58CX7iVpXipNjX3sZ+W6m3CB2JM47
+gaUS6RYj86wyKJgyXSXeVUAVpTiMh2yCSh8x734/N1aE9ZcSUVkFVXkWZZHaT1xVGtUI08weqV3ad8Ad
+8c1GtXXKu+zp6po5gIEagGUm/A9IduRZ0FwDstHtwXvICrZeLDdGZMM7IVHw4qwbZ2AHp6Uufg+nLVU8
+lNNKzOnTX8xXme3y6f/9W3SCJKmZ/QSZ85Pghn12DHy1Ai0Zpdrd4+nDOSP29kRmUEAtNsUJ/n6PY4mH
+8pqYNwKKic1eRrds6jxyd3vEGz1OUksx97PXlKsOCTPBf2BhRipSaXb1Up7VLPOoRT4JDWPv8A+HXbsu
+Zg1rGAy4mhdWSFN1Y2a/ie9w/yfPp1qu1LVODOJmyXp43dOBs47oMQ7wj5/4xq6+OiCCuxhV88QXZSrT
+vj/4e9eeJQWS5MWurbYF28C2RaPqO7L8foHJk4ZddD0C3y/90ndQKYM6/qu9RDvbrx7AqkSHi93URvG2
+0u6LQoDvtw3xvuvVNMzZX9KodA7CsI1bsC1Mf+E0M2lylTfs9nZxbO3m6+Ix1BKGbtmLMMPAahtjIqup
+x6oVPpLrvY0ZiXY0zR307b+8+LijULaeoIJdIgvDcThDbEmOWRysXNzUokJM7K9TvCyTx13YLmVc4k3u
+salpFhdTCkHX98Z3lH0OzeIqgj+7y2sxULulToOviYAi4Pa7SCkIicO2hz02eAdkZ9jCqNIS8szeIVBs
+95Yo1oFDne5z4XrWN/3GoLEdXs7DjNjSWQpxlgGWFD3WG3iAAcuO/I7LTYVsIb0NrynCAr/L8OAD6Zsc
+SXvo/4G6nkgQPVUYHF4eXnxNq0cuk7ZYJruWiDZdh+t4O1LzCEElwmfBgMb/fsZdiWySpKx3lVknvW97
+j+QncXnDWpW4ynpJOpefnYmLd2T80H3UP3ls/ZWlbHlnPc0uP7M4zDxLng16tkjW/MLWV/E1y/JoHuId
+Y9YHhqeQoWXryHoxjXK8UKEGE6imd3giaZYytrjD9N8iYn1qEVjkHH/z6iPIkwnDpDJfFc5t956WckBH
+o/Ig7n3y0uB+67GAbmMoTgt760Bk1SheRcFL0Y72MgwY+fC8BB4y7NKbwBOoBJMw9xbBGgTmGZ1T82bw
+4zLM3t3E78VtKF4GRUEyvwCF2FvB8xKk/mWAW8TTNbR0rR4/0J14cyxO+cm9KTxSYnVviR0noLfcwYOI
+APYu4BllySvx+1YMMMror3cjh4dp2b1PQUQJhb2rQKEqdu+lr1AlO0pm1tUw9ikOuvSOq6ufblKk7WkQ
+e9cJyK++62OKqCtoazuw1bk+uxWIM3/sFuc7Gzqmj8tkul6woyP+tyfKHh054ikofwiuXE889j7BDz/G
+P95V7+8vPpy9evc2sImQ7QFSwNPgiqwQ+CPQrw3cyz3m3kczBxWvVhC78Aj9xqpoEGSu+uVg6YGwRB1p
+0kGZjnx276Eo2YHroA9UpMy+6HQ9WIPlB/XwBpTFwmFePFqPvbUXu9BC6vIZ2FLrspEQgMZZA6x6vMFw
+d4MhNDn28N9Ks4MrJDhoboKXnU3yMg5SvlhYMBoPtJsFgiAeMn+F+MDKQbAa0pODNXznKdTVmll7kXvP
+V4gEKfX4a3cLEwaEgZ28COwPROeYTgvYYX4nkvjRNStxotIvXoeLNbMBcL4saP4W0wX8jeKr+hBwIjni
+1TFIgavTRwMxwzAgIKoYhul6lzgs0XQQXApEWUCFTg59UNJtnG/A/FAW5AMvfrnYcAUNkRe69+thGqhp
+QSzgW99JYbmsg1Yf8dFau/klaE6UWg31uxdpCtP+wpUzkG69K50lCASk/+a4r4tx82aD4PqgwVPpMgbE
+K5cmVqf2mRO1YFlEHDSNjAcFCW+ruEu8ibdw7ydBOAxH3W409uEfr4rK0WRM5Qib+OPLEIq5bAEctMOa
+VoEs/85A3zLMuEzfQ4fBzAIYPmeGSO68M2Tg0F3GvnDRzYkJ8epBMB/KHzuWXgWmoyOxFmOx+oiarkxg
+iN4lvOW2QT7KEi2tC3y9hcrYLAknGCV8q/HWfLMhkoowHDjK7zit4JxVB732pzho3lgQTIfimQjRNy4z
+3oKzDtZHRwUXpG/ukE+FnyJpAG1w7vMOJwMkKEIb3x0O7Ukd2iVCS20FwXLIH/fCaq2hh3VQAzYtKIcD
+e6UuXSCGR7puCdxCZguIWif+HYIk9AKA6m5Y3Jycu62ge+KXabpoA0rnW5rMKL5OPle7oi38IOFQKz7j
+PQLCQvAyqS0hL1DUhJLC1JvDhrkfj/KxK06vx0hH1PlqsZ58Ng+zuT0L2+L1bzDhcjN9R9kLFDgA5TCV
+9DEa+1ejdGgjY7B9my8Be1zpSUpkSpqck9QdpWPgcTH8EexTEUmrz6HBJv9Rg0iDh8AlKJGFeTjE2xr0
+0FcrPzq6kgodgAPTPOqPSfGAvzjpnKU++e7bbx9/61YuDBRYxp9eTCJLtsexEbuiRvfkuD/gPOkec32h
+ou3jS49kMT1u5fyaWBBn9vmwwor8eBCeoqLMm0S9LrjnTcae6ijcEqPq0QdCRhT/0ciI4oORYZmQUeDi
+30DFky9HRXa5ns0WrKSyk08ebJq+p4uQpxXCRW6WgvmVLJ0U1EWPjdLuyThgsGQ8/CeIpbDKQgyrb1o8
+VeXiyaPNJh3GI9W6RHv3xIUVJSEGELip5PQ9tY/Wh0Ug+PFnkxVS4iixC2bIrOAvSgSP4vEW+STu7/54
+1yBbP5c4ErEXp85JoIYScmo2iHP6uTdJI+AIUeizYiqx/BavIS7FU2msMu7JajA7ufqB9JYC1+BGR3rK
+AImogHETShIelmKn6WbDqt+6J1vJ8zhn7+b8L0yizRVmgdiXJsQWaNT1RjCKQXcjKZIOC9nnf3ZStyCr
+vMBXivKMPHJgTCrNLITRD0AYewmxc29Ns4PXX65gel461QmCeQATmZA2RA5OIgFVVK62UBtXfHTG+lQ8
+pUKTZI3ZQSqF3PtKD50O7+OEKuHcsSlZzQaVOm3ESGHhpXLwuQsY7HthoQGHp9FA4agTnp6egnAo7LRk
+7D5ZD6Mg6Zz4YZDISY1wHeYJcTajeT7UGd9QyOMYFfK6LTqUdK6pM7AwiUpQFQfJR8s++o0Z+xJqRd/c
+uHzwlWov1XoSfGmGjAdPI8KfPPzcyFdkN0Ia85855y39sS+H6CHP4OoJWYdNzWnlFUNytEZPfGQ90A56
+TH4PTAWb04BTnE19zb2+K1RuwgRocmi5TtNktRf0CrhbIvDlKixp7iatXZtozgTeNPVFIo9r11SN05Q7
+nAjxCDyupsbiciqIb7Phv4RUQHLMh6Fe/Y3o0081OyQl1Xa2CPOcxWa1iNcDPkAKXZRfJuuGgU+j2QwU
+KEyZHXs15fSET8A6jn4N+J81Myz0ivpKEjkFsZp7Oaj90gBI1WrCSigdgFPBgtcF71rnkNCykw/BoA5H
+YUEy6JLxC9XeCQEhgEonlDzPiwSyRmxMbpNIjCHRsKWhAIflKIRqo28J+qN0+RmjinX1ITBgbVAlLNFL
+7HrGWZBGmkFGXxXmB1DEaYB6MUFVzJwBJkmEzDing13WqrJUNRxj17zX36KVjkTJyOOAFG9H6gdFdzaf
+ONsVTl4HkIq+ZHZjyXWACtggPk0HqF2hTRAYmrE7hSTNERKeSbNC/4WzRkj7+60SNsJ13ffWhYxZn7IB
+w16H6QjpZRzk8K/PfwDzBN0OH07GJQeINA8NmnW59+6JUH9LnaLaQoXteL28AEtJOXFT5UUKSsKVBuch
+KGhkDtGMGECjp+lQUwjXHWQU6BKq2rBuWeEhWhJSWGEAYRftKyAGSmMS3P7VFw+cO4VTHPMF2RVaK0Fw
+oboalj5JF1npla42MLD2i0nsdgcc/nUJ/nUJ/hRTItYgr+nkwQk58OLNBsRf0EfiVGVGj8abzYkCgwXl
+++QnLFo4Tt6N3WPgQn3pby5IHT3fp2uAdbTudIC0vLgDqFGKC6Hsg768kNGjI9HkSvAYIvUTIpWKBMEn
+OfBPyilgXP1osFXsA5PbT5F8g9NCgxNbdKr7HMwt6E6k2PNSyQWqTboA14dioyjQNo2E+EDYPpTLYLCU
+dJvyHta7e5BeSWIdToTu/WgY+aBmo+MkTCtq0QEcvi5QYj2fYL4DGuoUp+7p4tBOAc99pHC5VVLMGnfU
+2qI5a4mZyy/wfFWWYc5wuY+LMX+ZbTZJcmEPCN81PhPb92LyJLBlUlZzC0vtXpnxNeefV9lMREtOw4+W
+blHJJLQ3UPgDSyZWDEwpNtchDE7ZIrw71NcmezHENtRnEOmL3LNcgaQsGw1aFMIgal15o9g7GTdNPK5A
+ag7nLs8N3gJCE3o60BhCEBIQj2DJOohorkxN9MWXBJgBKcTDTkAbrRM0N8RVM7KFYoGQz9lAvHSyOJD1
+BglozrLRFjYK3SfBgvc+C/Kus+gmCpuM7xBr2yde/zSYDR26K08iOnSLAVVBcv0Qe8zTkC6AC0SXYaBN
+1MSbuajM8bm4AHt1shN5XmIYZ1QHNSyGXcNtGS3dcJCfLoasDFXexZ0Uzo68lBAlhxbh0GC2F0F6dNRS
+uyIMClUbgaEtQPJU6noJDTcxankgmgqnep2M02GOGzytPm3dGxcQSDiCOudLCTekzQZFBSVpMIqVSlRY
+LFrDpVWe8ubRAksyFtTiC1Q9w2CksM21GUuDwoQcpKe4PLpdNwegQHkssV9XQZmDNkdQ0D0Ve0cpNIdu
+/ORk2MCx5HYDNor2e3Cz2ehzhBoJrB0hbMwy1rFfxdfhAtrh6qzNV1iORlHJXx670hGTurhRI2ydkkbM
+3RKByeeea7vgqZIfIMQL5SQlfQXQyTfFGSpTIANwN1zRLReTUfqHdkJ9eKKrcl9RDIZR3tAZaPOp1iNX
+stOaZo9EgZr9OMA/JYTJdnF/aMnASp5mBkFsmgpdecLdC9OUcM8m3927BctyapIdT00CoiSTcZ/Ereyd
+YJ8BWkpSOq+iyWcD6ISiw2zBp7B+C9sb4KOhoi6MfdEgoTNueS2j/Hd2pjR4iUhlYqbA7lB54GS3HpdJ
+W9ywmf3BKJSuYdzZlyhVGs9kkcSsybeuVnXZnRgLTz16CfmkO4AW9C6SyG9ir9gQdEpr/8zgYEFWols2
+fdw1A1PkGN6eHOdajMJmI1xfrr4tOSgHJVEgQ6wCkGBaq59zWL7qs1DAF9JVit2tW/J37hZ7d9lNhHey
+r917vPTHHnGmZvGIrrHtFzDxV2jUlUq+JYNYK9kKOvEwh39zv4/+RDlkH/508nJlFNFQtfTuxyQBHSRW
+LXYM9T6w+YvbldZpj2cnQUWbP6GRNV8kFyFiVjxRSA/QJCosVFT9IOt7Hicpewbd4KfiF5rntuD1yuyH
+Wau9ywusSsqNCu4WCZM3HUXjwuq2GP3MB3znLMbVleXpepInKShduf4bJzEEMkpAMXFKDC0EbhbqBBHy
+zT1VIIECiV4g0UhAeSo9GcckVda+N8OQBUSAxDytm7EdBGui8YmS61B0otk55KmYdLtAmbPgzKGYlRz/
+wdXhDtwBxXwp2ZBx7gItSqGZoVNy0ul4LWiAv8zpJTaWYWOZaMx1+ek7qDzj0iZT+9V6xRaAI4rCsCZb
+uf8A409WDo2e/s7Qho+yF7+uKx53tfjPhLNW+mvFNmpQ1SVKDhaOyLo3WayrYgsW7UXlKVHCs4YgfRWr
+HXgODE8cb2CELaeFTAh5UQ9PVqFWI0bAt19uywoRr1WffMVYeN13VcdeKcRCMd6t99QZ2cp7bnu2JFB4
+5GiAB85Q4AGZA/zha90el53yIzvK7E48DgwOWMXmgkCBDmU79tje8u01zYVfaIcgy0qfGvHXklNgYz+M
+2eSzLu75kxzhuHcMNKwvREOTRbVAMRcB5Evc9jHKM/mNAgFaUfY2fOtQavKXiyQkzZVPDLxvEocczTyS
+AFk2ryCYb9NUtvogrYSpV2fYNbp4u674SUpbTYHo82cZGtvUq9DaPUJ7gx0gt5G44R8nz8QduYHJWfAJ
+9znANNhqm0fGzkkFwEPZNau18Cjy1ax5dWOXq8yxrjKriIi1W9KXeRzBrhinnHs3uWsz7lBHs0UC/dOj
+CERwH6Ibs3MiQwxeBfcsm4Qr5t/bR7ZvH4XL1QBW0xN8XuT4eIqPc3xs2214/HWd0Ps2vv/69tH3A1gu
+g1e9dcxbCqR277zq8TdcTnxUXZHDj5YrCAy7IzR9Vbp3lUSxY9surkTPntuAp9hQ1dGqygKy8gZru7w2
+8mk6ajmyeSF4LcvX+IWZVQgUD23bB8A6uauSl32EGqbdnVfwnkde0Srl55/27WlYnIQHIkwC6g9MUR+p
+O0zV7nbKo4Fuo/JqfOpoVlBpe0p50a4owgU7uSp8r/iuZsaPSmHtNf9ArPsHfhNcldwDynOGrGYraO4t
+EL3cc3w1Ndgbnc7bjm2roMJhDDoiGQsyge2ZuE8RKApNY3jjHz/5kzM6z87Pxp2h+6fT47lHm3urZCG+
+BpXPgqDgS7f8hQP5a3Ds9Nxfjr0fg3si9TZQzfk5PMA/8JTCE0qf8xgeUDD9F/zN8cX6Uf/Rn+EH/yte
+/CBe/GBvvefB8fn5pr05Tzfn8eY83/Aq/M8Px/NBMdCmGNygMJzQDEkNuHF17zpfMSMnFWtss/nVFRqw
+By81VFW+SPxqr8f6Ets8ECuUh3jYnz6tOkHbHsRqgWgbv2TzeGsvKQKnOoE0rCJ4rSo9N2xbIuI7P2Jg
+k+ulwOygrt3unMeO8+lTHgAzSGHFgyzHJTVst/1PYqz42XWhYBvAZKaKrFIRyovia1l8APPcWXdgusUI
+ccRJR7lCcgBKFQS6B9xHeKaJXJ02btQ7IAY3m/ute49NhR17C3/RcWrT/X6fcg9aDtpt+HNVPYVDGPfs
+jr0C5ackqwgaqFG1kzF74nYgepKbR59W+GaQp3f33HVTcBQNYDRYYFI/2V7obid4/B3sgnvu4kqkARV6
+yZaMbymkYHKvai7sQkQyjSvEUFJ5qieyRaXgwITU4UFurhBnexMy4y/DyKiC4EEb/tURLOc3UzHO1Kjc
+UK/i8xMhyFKdK5eUUdD4ASVo9cCfFDf1M5Qi2WU0wzWP/iD8s0JKJunCv5SFC2duDB2sOsONaww3DUr8
+tvA7GdyxAoYWHmKQALRokxO0d2lVbjZThkmBrXTUH5c5NMknHCLf1QDgkdZwMNTU7xiBgFbvRfezqmFp
+oxBCUvhVtKa9e5oTv968NnnkCCc1jUcnNhUW/aIccjlw/H4BOvH3sGVd/W3N0jvr+qR30u89sjbyFN+3
+nkXH+MT3l8k6nvKkndareNKDgle/4hc8AHgsDtt99fBYO27H5NYVRshEyhFAZ+XkzRV0gk5mvveyIFQ/
+hJ3mLaAAhwH0RtZ74E3QM7fCWJsZnupCqG1vGqzkqbtLeKQzeXN44GfylvAkz8bdBZPiUN41/KgcyrsI
+Zj34uPS0EECm653AQW57s5jCzvALbandBMejTnc8dIb++fTheW/jnk878GPEXozpA/zcuMdSxnwEYXjW
+AXn8LDj+BeXw+uWLly/Pb5/2x51N5fcDKPYWimHT2UPnyej85vwf486pO/rldPxw8zXI8Zvu+KHrPjj2
+PkO5J875TceFoufHw1Oo9OT8+PzkdIOfX1BvY8+/355n44fw5gwE/tD/ZeNvPJd3cD5yEbCnKK5xACCA
+zo8vZnGajzfr0fk07M6edl+O77/ZulDsKji2R79gmfQ8Hj+0N3jtw4ZyH5PnbtPlKOk0omSOmsEv3WXW
+PfZeB8eolkwxMS58ibyfzHOQwwz+DFSdogsKXdK/auXceydUdwdjmjMWsxQYKl6eAVYY0BBSIryQd1fj
+y1C7bhR9K58c17vl7xy0Fz/pa73e/NDBBjCvV+m1Yz9/90ZkbcYrFtnU9n71WifoTjEWJxh5EdeHNqcM
+L+mmQo5dvxETimJTlVKiETR2kFCDWy3G4J4vWn/mab4z/9ajTAsarmOpdoGCQgEWzNW4Cjm9+P2LhWHO
+/bpRAFYUYRlATJ9ifBSw6NPSK1bEheORJHE0LXg8HNHuHaNwx7H/Flgjm8CEeq1os2lFoxMM5Zd+HXQN
+9/hohg78SF06XAHF6YSq7htEKSe+0Tk0aEj4oEt+5FseXxrDxC9ZOmeci9/yCyh/+vjmNdX0YvSHSv/Q
+MO4lNzB18mwzAOWHFGnnfQblNMt5b0dHt2DKvF8A3y72z9BKjrjn6rYUcQjdYi7RoXhwKLMoH1WY51DJ
+47lGtRlBtSQBKq7cjBiNHo1dLzk6SkSKubeY4QnHnoBtD7KSCihXn0QSdSUifE9IyuBxChWTLPGLd4WA
+TkQ/+XG2JA0Yl0pKA1KYckrVZKO8eLkz1y/hA0MnxFLEk1RM9dUS9n+5f/XolTpk8gkX9jL8zEQME+8R
+lris5tu2JwKZQcLyyGuDdJ0Xkh4qA959nQWVLGhGMymjuEHV6p/yVyNt7B02pikesTGIN5BfwIsmn0uN
+ckVEp84SleORvkJrwlw+wvVYhO4o1MsfuAOGXgLfyGdvuQeB6jPyHtE0mEYqmCWymmWETBkEecxw8XIl
+hQRxk5KihuvMzXvT0DMFkDc1wH518LQUxtftKAL8Bkb7qwGn2jx4cQCmkUNT5PdLi0yDMz4N+kdH+Wk8
+pDkEZXDsk797Ga5M6KlUv+VnpnGQmp1YNxw88tnh8EFFbMSemunNps76MH0QJyj/0kOVHeCkrVyP6830
+k562ntJrNImB7+CD2PKlEtXtXxmmQnqel3ghKnIqyLA/RgMQ9LgTr34gGbQ5sSNkX3BPaSFQ8LT/JMhK
+jZ3Ixh6BESD2mIoKm02JcZDXOsMoI+g5CDAeJeOLodtduIP16WKwEKGiFNaJHFT1tBhrHDpxWYCs2EuD
+BP9kaGYgeEdH+KfK3FPsGAWv3M5IXdcFKQX/D8NFnZcLBBlGOcRZcH31Xm+LvsKQsftAzoMz8ShO3Jc8
+kL6mxfZ3tlVz5tyz2xX6QX2b69Fgac46Jfdo4YI4Pn8OGpkNJnrhJTYdtQJFHDB6i6Hx8LSGVY6SnLev
+PoifC+C4Wy/K6EZ4H8ZPnOIfIWgcJ95lsph+qDEVNhT8BEt1Or5UxehMZJ0H4eEocsAPW92uVtNvITap
+eR7UGfYwC4tbj5oTldyBqoD2FcNYMVjnpUZP+zi56NtMFtfsH+jkCL3R7djlywe0ovmcAVXcOqErfzk2
+VbfdXjKbqR8YRlSQq4Ft6NsfMPdI5g5uSQnK8UtZPbTNqaIByqNQq/0PsgCbBFYLyZChvsYtRazxFlZF
+Gk0MVeobLMwFfUdtwmCPlIVqh3hkHdv2a8sZdfT6/g8bTkZ34tiUOy52mn35HcHVFlCN28dcn9X3qAvs
+bDaFxsK5CceVw7QNRXQlIcnpjPboqHUtD3PZ2nvb1b7oFTRz3waA5Y93M7voSTii1CEdeAP93vay9QpT
+naDq+RpEHvEpVGUtpaUrWGIepCK+DvQois1GK4VYo33aBqzl1Eyud1LaWmUY/lWqxX1nPCwMiUCp0SVd
+w+O7wTQfwqIoEsS4Gp0M6gIip8APcSoH/oURhWIX4bOyHaKgBRp7cSgnBYktrtgTmrKTooo+xuDC297F
+OlpMX6bhnL6AMgYgRtAKrufIFVYbGYdcCxuNMUQbU3Ciak2aCg0Ur673jS5B/ILMsrjcfqj/oLN7Ygtw
+GPs1M4un7bglHwWF2RwdveCWRuF9furZ/8sumPqVZ4+1n2fI30EeKTkpXaV2J3ZBOwUCY+VgPhoMfSYl
+kOD8pz6RsQzij2hxxYbJjEuTSd7YHljH77EtsJ/5uQL1AgRtxPHxEhRKGQXg8esJb5cL2+XZPjASb4LZ
+o/4pxKX9JpqkSZbM8h5ACO2BKEt7YXYXTwKb/BLoqEYTGT7TXrBy9qZBrqIeQK5XPVGwjlPNvsp+vPsY
+0h0cjk2ApoQ021XexxoaEWUci+kW5Guy0jU6sCEoDucFlC1J3Bx1ApptfhSNKPuMrmzWWH6OiXZw36I4
+gLp1HZytCYC4QEeJiQMzbfPBXmZdjUxeez+5COaU0c1/RgOBM0v8jhQtn8HSeZ3cSO8MBfiX3hhMDq+g
+oKDPPYRcPwyDN8Js54wi5LvLg+QUSI0rb6mKwcd4IY+iNXEXnoezFOmTIs68DqzR3BdHMFaMPPxzWH+7
+a2nb3jjV/gVQ2wWvY5+vZ2w2O1/3+2Hfdoc75ChI0QspGrf+7oIOCt1itp8hU0DzRRjGvsGNiwy2nJmG
+VAXnjaN0VXcoGSMs1pqHaIgnIMCGv5QBCZhOKAbRE9f7VBQxoB0XnPulZCFLaR4p+abF58Z4QhkMMy3o
+IO0gU/UxUDcexELlpzAosOHQkY/H71VYQ3HKikZScosVh/lFb1FBqniuQTsFFxSn4IiK0tNkkFDEQ0Sn
+pUbJmCcOuwHpAfiC36jMa9/hj6IL6SGJMDPDHGatcfmAREpoW5LpYVJgeLQw5waHIYVfMF3QpZfADHBr
+RpwzxbducYqrYtHuXapgrgGN0OopLR6YIUn+KLX4OTqyXKQtiAbMoLp6DqwmwJ2KZQ04IK/MOpqCjQGa
+1u2daRrRWhVVjXI2oV0foCjmsSBBoV92SwHZzQ1nySLDxhCTUQPcRi/Odc0NJ6m8qIegA2q1PyhQ8G+n
+g0lD8q0X8qytZa+tNMDlWY8+7ZooY1tkMBhoYZuFcQD2432CMY2I/wXiP3Vve7wban3hpWAXe2AZYQ+K
+T0bCCMW6ZRxF3AIHs82b4KSBASzlUyRPa4Agn1AET5XI1F4p1bhF2sLzW/ivK856cgMeaiBYqZcNI1+m
+n8A3C09+0k7LJWBPT4YSDtdfD7FQHwv5IQq8m7qjxZFHZlzUANBoRCmW3dSWhuY2h/UgTusmPOIyhIWF
+7km8cxlXXvEY5MgM9CNEKXJaV6+tlcaGigW6VTsV0vkWVILFWxQaiUrjczzzlbIpKLDN2x8ms1iJxNrW
+h1t/tWvbo15W2/OgbnB7JT9k26NSSm57CAtA2GkyaQfrzVJQTZQuV9toFHpgBJqY0P5Ax0oWi6Mjddow
+EUdDNacCdaHKwmDYLLelTlnIXQ2lifdt392W9pe2W/ifir+THlTU3YQD1hZRhCKeXASgq0AGHnpK9CmC
+XsSRUG592eTfAxxZthYQRWJ9MtJiQHOMPBvXlDWgQtnPm8Jjygpxq4zngfIFa2YzJiE7od0faVeDzB22
++n7hm4h1Q7/FMxP2uYVaE6YosU/R9do94QJiC1YbnsVr2Hbm3BA4wdqbeCtv5k29S2/uLb0779q7COws
++u23BbM7XXXG8Ebfkf4IDPQZ/O9tAAaW633mf17wP2fozHtq3qJkdLLdOUOW2Hd9DNAsdsCfBydPnjw+
+8V4Dh6juP/+EAvzX4CeMt/Y+4V/cy34jH97BA9/UfglPYlO77PrhE4THvTW3Nj8dH5/mg1ykCKX9h5Lq
+k2sHzH8M7Mklm3xm0w3fGIEHsqI24TpPZoCfjJ5Ae7zb4GYC0H+2oTOlm2mUYRDLdHMZTacs3kQZ6BCb
+BRg8GzpUAGxnA6ONN0j/sHDv4OHXdZRiXxP4AMv4fWCPzs9vH/XPz3PcWz6Pz89nY9v7ENi4nwz/9TZQ
+4KY73ox+gYL9fhf+Dftjt2N7/wg+KL3WvrE9++ZroPwHgX1+PrI77zv2Q8fufMBISf5j6Dujh7882LT+
+NR4G+sv2uT12naLDX/Dv2H04dM/PH2+gkX9AIxv4P14HvtneqwCjJal5qug4e9upfHBcGNl4vLE7D9Qw
+Hnt/xmCgh+6m9xAqYZfeb4GMCf2F+u9QS7+o5mWzUIt/l1Fr/6xUfOjxP/Dp5+onZ3Ta+RfC8l7hC4r9
+XRbD3yMoAO/+t6oayKoAyBjH/lBHEIHwV1n4lev9Re8TMPoAvv8tuH/13Ffvv5YT5nrPXj89Oyu+wPiK
+bx+f/qX4gq8rZPCQx8e63tOPHz/4Wq8PXO/92Yufn7/TXwJoz3569VoDw3eIWmkraoObTZsYDH34Xxd/
+uF2HHEGbZNbFpS7mXyCDgZzaJNMpTBJGP2xc5/x8+tCNNzrB0QfxGz53YJ4V6mjO7QigR0eYNs6hb3d+
+hHE9EJ9jxqbZM76/51emk8+mX0DDft3MYSx8JMXAyrDDD1heU3dIIGsAOcNg9AvA/ECAtvX+G0NMfrkf
+d87vKZgk5rm9z2+Ovf/Dg1hEwAqMjQJVNjCB4gWGqLCcl4ri1ToXrGeDIwmBWWwu1nmexFAu8nIseHk+
+xecYntub8/PjuZfmippoLcFSwpCV8f2J992WIB9u+LBgKRHUFMeZB0YTJ7D7tyAYu5g38DvlQURzabOJ
+cX/wNB1yYQzqRbJ8dhmmz0DKOWmHari+6eO33z764btNenp60ve+/e7xo/7mpP/o8RHmQUJ14o1QAn8K
+3ol4Ld2/6JV//TTSf0uLSIla6dwCufQmuKd2/Z9kvq6y3PqkdM936iDc1ugFUKIf89xw4xXMJLRZ81EK
+f0CNk9YqipLtVukPYS60ZJENUZfNU5LJt2iZOJgjsRJWkfs3LuB9dnS0Asi4s3eGFgQqyt4e9zE2iud6
+nAVoN+poz9HRD/BuIUpxo/UST4zwAIng/0gfMqUADxIZNvID7iVyZxSenitHXMCctMLNphXqARc6HGEv
+mlIO8ULhQ6s7REeI1LIro8dw3KDyrt4vjOfayT067ranDxqfFvnxRoW052YPJ/lpaGoQD48xoKXiC30G
+XCPjjsC84cve3lRJHA2Aip6z3q9ZCONvzQGnc+7nxlNt5A4KpsGFdxfk3m3wA9/fZd4Jf9D2dvIGvySm
+lV5ia54z5fP4NBd3CTh2NLVddwg9KAkCyiBwlAdHNtjevaxa2Fu63hJ0FsB72+4sO3Z7bNlgek+k+sXX
+ybrbdSd44mXZucudCZ0Lvgv+LsdFx64V2QDRw8gmIvDcA+6Kmz8umRxVTN71KBLqTMSwPEWDmXDIGcBH
+sGpmUQzL+u5+Cu2KfYzKgLfq1N9nDNKSI//Nsx+cAJPkC7dYzagDF/mq1eucDvUXfg9+frIToPlxikeb
+Qa98TUg5OhIBuvkIrFuM6HVcPLsYYxJHdVxaNbzIdcf16GJMW7TF93VeaL+zyhaPPY2ubXdQ4K7VIguL
+oyfWNtgkmvSZKM+LQB7du8Z5EZqYGpub5GV2KcyvDW5FFF45ThGY6SGhsK6fwngK9j0e3AZOWrS2KrWG
+++yYMOLoqGpUnQSBxt1gzfxLHvellFObzXNQUP7Fqu94+i4p2rijXXgk8RwkSN6z6AKTmLiVU9tdlauD
+DU98YPQK4pk+UdUTOHHjgpSeOBL9ZBci3ikIBUaq4Xf6b7XvaB2A1OAqBf1yG/q71PtbaCl2tIjUoJN7
+i1Li18ITFNA2oXIZ53j+ISmTQQhkEI8iYK7heEzH9yMgb34emoL9XMqTlwVhDsb1P9+8DuoWH6PdoYrc
+ZG7V0aEimoY27saWWaQPKwBThOVyj5kHW4fE8mSrtb5j3MOv9ezfUFYccWTn7xG7GRTH4FGS/xDoR20x
+rLIC6tCZBbE3DWofvMugRee7InRba44g+IlRjXjBWuULuoguGFjBbC1cRZqXb+VQJkpah8QQs0BPpaTv
+l02kkApA4QXFoyI41Gebt2gSp41t87uBOGOJBQd7liw5BwOBJLoz7EA+VPuO9V6VWG3sN4ph5pAYAvsJ
+MEqLBhG0w/bpk2P4fVp6aUXyte0xnteVIK5g5hGxpwb5jrZCBV7UYhoAnJYQAxQdoeBvxeXWsWFQEgxv
+nQtTZ0MnoYDX3qvnFRcOamDCVVNRs4C2ro6OLgtOU9HCtAgLJOdCZgxBqGF23a2XiCSV5W4Lt5qUumkO
+Vkk9L5s+bXWVhXL2b13fEYJVjfAP6FYMWejWpc5xhBw19fccsBJa6MCKANUTIILpHphXy7C8cSO5l9il
+MVZBWIZxkyrr50a7BtgecGm+p9WoBtPGyUOKZnfvpZhMaMfOPamwM5U6oki2KbcgtnLY5M0ImnXpLxi7
+qiWIdNj0naMA1FUY7hz/4Vp28N8yjKSqSqJp4VSWZplpcDv99Am/ANGSHsOgjayCv4QHUQrZRk1btUey
+0lgLoZhzFGqOu6FP1LPhLg8822hoyxeuS0NLxSeMCi2Pia+FuKo6cnUBlMeK2o/TAGo5d3ISJDqbyt1q
+cZviKo1jz38BVBUDPzqS4KJfchyosbfbm3P7vHncLCbHq2nc8pNn+9I/29DKQ8+/hU+yptd76Nu07wZ0
+QhcDs0yWlzRzB4bSDbv4HOVvygU2m2lvmfxmeJuYSmaVl0h5VZnQA/AnCRAfEguVD+5U5B2p+V7xe5S1
+ELM0oKUYUCuwvVc4/fNgrhAunEbz4pgvGnTL6vel/v1aDn+q8j65OAxKzZ0yqQq9T7IIoR+anCk/lLT4
+IatqOj5q+3nZABloewyAt5bTSnneklSLXWw5sYJqGGuJqVyfNUEIRsR3R41fKYq5yjopOIMzQkyypDk9
+8Iu2u6Bu90EN82lwKJZEaK86css3VESa1Lx5IOx3fNIuhoiGJ0d46CilUPnndMAK7+Zo7BFIk7KvMm5W
+XDs3mPKze+LnxYvcBUNpMnzJqXMCBbrqOXf9vv/NUYRVTppniD6bxFcRjVFMgReWZgQjM9jYWwSY/KAB
+r7i9maD7ytVoLFbjAPgT/BHuHsiA/GdBoFoRNuwAzF9p9wapTixZT5wepoxzQW4utdBL8RIU+B4EC7SR
+QAoX8wddUsQ+ffFFsRsEfiGfT3BbLnb92dYDA0ewNvN2Hnku6XSaTDCpVVHcsEa6JntMejEZeTGV4vW/
+PTtoPzhBKeDhiq42DpMC7Hx5dLTkPCcHVjNHQSF+ueQY4kzlrggCJIMec5HWuCaGOk+Vh/EE+UfxovCS
+qrAobX9c4ST3ZhwhmJteHuTrE27UXVUmfO7ByzWPX8Rm0CILylrQ7sqCNZR9KmVHAGbpB8PxNceSXtKr
+lHSHEQW+tC5BZVIJQGhrVzcWcW6GFX0cb0sIA4M+HLvkoc1WbBLNIjYdhj1xvwwgEiNQYNQUOBo0RVPb
+Z3eA21uLSnnWOk7ZJJnH0W9sipdqpizL6JZvu8M4EnnWkbMkrZvuuRcXai8tXOAEqbgM7/kaDwaBLpTh
+OR3OC8/o1lrkouISH1QiKDXkU9c7k0oxnpfH+DWXRMAoQZ8GOgNIAieuXL1Rt+sycfyIfB7eiYqEYAA6
+Oh4w4AaPrZkgt2267SjSRCid8KTFh/o48PAf+J8T+snz1NcCE3u4wyQiVhTn01/S9jkLdHt3wAb4QveQ
+xZ0gxNBL6cd/zLv+hnouhcz+na5xUsFuA8RXOqYbA6iRQhLFYCtw/wvnA1lwr7lQ/W/7HldX32dsPU38
+Re4R4/D/5hVkjeeH0NzAvylb0Jacf485ju6nUerbBYu1xTk7DOm3LcP3LSbkkK9Tdh0l60wMv1T3X02F
+wASGVy/JGPXvaSPWZNyO6Eaqk3HFMPXY6PE4cNjomzEs+9G3eABEi2IVhex/BWSijR4h3VEVG1cDPHQo
+CkYRr/cNrBC+y7sTihJX8Ow4v+QdwCfZ0mO88xF62GzkAqawMgT5m3HQIZiHCDI+foeZ913/0UPHxq1Y
+3thjOs4yncpfLtb9ltf9fgzg/7lWwMc/wE4qPW7ldnbNgMcky9goLGDAjiSyv/UIB2IfAtsYEjdyePMI
+eotuaoA6Af7y06Ojv/LimMAXjOAlPNHBZ/qVqqsZMB+VzMjRjd2ufKZYdjoBDP8oJGKeRewj1d7o0/WY
+Dk3NBPXgfv9+Z4bZy8yN+GEt1A+o1xwof1ikO0DHIxTqcL0dMaS+cTm5vMrr9YsKsoBifIsa99URoW9B
+CJkOlfIJMLCzSeFB0H5sNkbvjclzIxyZtkvrC+9KqazUUoimnmiXi18huSnxffmezGiIBhiiys+HTtRB
+Hm7zF8MIdUxffh+iKxd+/iJ+guqOUWGRIi0QsfbD4qP+4RT0PPuB/o1TUFddy8y7+pcogsFxnYiYQ7WV
+jQ7cZhMVlClPbp9QYx27a/stWNQtzKFd5Sk8OFRtfAfEQkjlKkgb0yJh8IX+vvsNRlTbIqSEIJH4RIGW
+CpwYTgW0WrotoBE1QrIQN73pQXFBAv2GQ1uTaLaByS/LRsVdkKFZZF4RYCK3FkdHLcoYseT76lJFmLv3
+K6Xor4LVaD6mjfbhqnl53VEo4aqqnbZOBpfBPMAgUAooBLJvgc1eGslWLW/c5g9G4XCpCXN/SZez0DOo
+h0dH17Q9PLoAjuvgH3GKeAKKLkUcTIM1v2Ly49HRGtNCzEovHo29VTBFZb2IzhhNx2q0nQ58XMH/w6ih
+h1kwDfqw1i55llVXaC8rzZvY6aCCSybbPUIRjD7CtM3GA37qROkc1+irC5ycg54L0F1U0BEwDqKL0J6U
+zh8cAtMXTo4AmkByVhyglQYQDmEG4oqPqnwQZtYNIm/GHRxI4bM/AanPjjE7/nZrEG+6GzfprUgZymiy
+EvTDcX0DXlQsAU1YY/4qvucFajRvgKvQyn0LoA9TUM18KcNOT/BE94h5zAMulo89va9KYKdTDT8Y6tuF
+6kwCbhem/P5A4yZhFLyUxh3uFbp0liho8Q1DerN1TfIL2+xT/LyfogrGEeTfx0nuL0xuUNyxxH/SYFGP
+BSjjpDyQcnwPDYZf2oEJgYGXVTbAMxgUHrwfZWOuEmQ4HExgHCRueTAYzatd9knZQ/D6a34JB+oOrZgT
+K24sAPqrIzNsGev2fa6ZsNCAtGAPbsXJdZMBwyzIR47WC9AZ2uhKtjCULdjJIoznDR38RahjJIKbCJXq
+E5l6bI/mU0uGGQ+miUW7+pfDvEctVeNgbpcLHz8gANVv/H2RmL1iO3sYLMD46i1u6mIoJqX5V3UZVoMY
+3MJliMn6w7SUZkUPSBPpxCidkHjG9XdZ2nfiEvWEn0iMpmALJYkxbQs6veAzRTk3fZ/1Qjp4qk4UOK0Z
+dvmSQqM3xbODGlyr5ci8U6x3mbLZZvMveBFeUBwG5fcgl7xZ9ZQOezowuPXkz/2FwRIT+x1GPfnAOAw8
++trC7E08HhxMkJVMSiA+yb0bmUWnCTY9pEb/pRogdHjFbzEIhifTS00eZI/TIX05yFP7f9mbzeOSv53b
+5sygTxQn2zmUpswHSs70CD465Y73m7LUmAJGqOsFRHjQC3FsxJWpNI9V+TenUot4UanQilf51qO44fr5
+/2pTTX1CD9hC0T4ahKT6o1eowkNIqXWJuVTVCV5ZZf65NFxthXfguDzrz2XumByNo7x7gmXYr9UShfky
+wtOimG2XkqyiOV5vjTMafjJukNMp0uCRy6o7vAw3tKfTpvonh9Rf5CZQeTiygpQ31O3SbUGynbTUzvzw
+dvLTTic1N0MRC5LMwWYJNKL/VaWWuE/DaZSA5cPZzUVyi89gpjP8i7e23STpFJ+jZTjHl1u30NIwkyZm
+RCuay9YXS0zf0vdSBhpVvfyUl5cRWnMMRdzO9bxFMuAhKyAuqWeUO2CeF20s8+rRIRWcHHyWpjve6qCO
+ywz7/kR5QQeg2eDeyhishKSnXFxSy3HvKS2Ckwb/5GHFeNATVgadZQ0y0QxmKpUG6maTATHwKcFTvRjO
+iQeOoImfVRPC2SKCJ+VVserCckq8Qo1qCpxFe99Fp8ok5hMQUsIjgT23Bd39DdRK1eNms4afeMtGGuCT
+w6+r3QtF6IkNDT9t6p0fVeT2jMKxDGr3s2Hh5XL9zzBZC3WRvBaVepeXz0Bp0eq2XT4FlXbo0nvufi+C
+NFRT13l51eQgW/HiEUw6q7lE0UgBRfdZsfuUc7E0LJncUeEbp3t5hI2Xa8Io0RMLU7Bt2WqXp3r5Ybbg
+I9Jkh26Qz+qNG1oHoSvaUZuyW80E3AUYXWdSMSo9Bw3iVDMsV3zXa0HWpSvuRFjo7u8Fl+jSWuUNBKMV
+LBtytgr4NpuIv8DSBbDF3FyUgoELe6yEL+WOqtgcuO1ASWcwuyIvaJD8Pnomix5v85oXh257BlruU5Za
+QWdrcUks3zMYLE6zQQbERofJuY1DuSudhBrC36HcFsHM2+LiraywscIChhsNBs0WwuxDaIkhPwlu0CVL
+kZGtiL+L8B2Wd0shquL0RckTFJBrA/65DOQd1egY2mze5g4ex3xoe1kRrQDj8TMy65YBHsFoJXi2c+4D
+qubeDKxibN67C+JhhPcIDpl/iYk6h6OxH/pL2poEvdzBgx9UEuZrHUDlO28KP5w13iJDHybBujyDeFuN
+swIymxBG70ZTeELDcSmeVi6Fyyc8KSluEd7zjaE1jm4S3Bnbu+PtrfkcLOEXNDSI+NWZd8TcAZztnupA
+1ZHcHE+8FZiw+B4MPjwjj9H/LSfEPwgjXwl3NGrcMR/eye2wS0924vp3MJ9DAUYI2Fq4voz9h5/on1Uk
+8jHXNsgo8bKWoCHpye2fEbndkTMjAYfoJlGfUNIBReMmPwj04NocNcqVRbpWHHiRudBLlaiD7F1RGqis
+mmpWLDo86oFHq1p4hRKQDN7t4Rb0NhHF/ZV4cLf8MrtBpOXyK49yIUbpQq8AJPCNGWYQ4L6ve1GeCzut
+tH5lKr0Vwgtvrb4Yc/MjDTqdBfTML/qmnKpav6nst+TWgoW4OAU64GDQIwos5QRedE/UzeJCfsJs0HbP
+ovuINzmEVejb9lZL2yePaABOThdHRx+LJvHGVyCe05S/VX5l9ZbkJdCPVEWl5CUIC7J6VjqF0NcuRDzV
+c43AD23HH9UnZCtTXk8cvMZldA1NXAR23/Zu0X8Mb24E25x6z4I1nrfebELMWyujQzHs0pseHS1KJ2QW
+eCb7Y0cc8n8GFFtKbLjZ9LjOfUOrcsHDBOi2Vncgkj5eBm9xSgcXMIl0qIsCfOfqbNsScD/HrWx0YcOq
+hCG57j3PMe5cusL/ih18DD5Dy50OIhFdnpdBa4mNHR1dd7tehnmcRCXiTded4ALvdLgAqK7LPea8x6Vz
+i4sdOhQyHmud9kW81QUwnVuSyHdcLuOf4Fe+5IC3DDhXcbeSVayAVXg36I6HaVcTBtCpGx2QHkvxAtCO
+JAg5wnXwDBM6FvnUQKZkrp9tF0HIw6Mi/RbgeoAtJah5oWnULbxpgTbD6DwW6rl5mb/GeEomAALGw0Fg
+YpEnVkpNP5JPA2gXOnyG0g6Zgwq3LbT8txWdDoMH8tIZebmguUsQ1rEXa5vxqqHPlbOMKEulEKVh0Mgi
+7tKaSU5+T0cJZ6gtzbR9T1CI5Qw8AuXy1XNc8kCwmD3VFUa0ClznByf0oz6XuFAU78low5p4D4XnBUWg
+u7OWjEy3CcSuOUYUgSzHVCit4r7FQcEzMqnhc41ZjmkbBn/r6YeO5XE2sI+yugf7ntS+DEwHTwMaJbzO
+L1GNXAUccCFVo2C1E35xjC6Tgq1+nE4cHMykiA3xZk0WRCrK8y6nA5yGo4qYrkZutUi6Qif8zAVay73W
+JVCCOseHB+/0aJngQp4Bs10ZK6Pue4K5BDZQi7gJzjy8b68cgNhwQOJkR0yj+SScIfrZeBoktNBZGbS/
+xkDu8NT27K+5Y0c791H26GB5NDA3G8zGR/4l8ndesmh+mW9uoml+aXtm+U93PFfjqDxb7nxWHMzA7B9V
+j+3Uw4VNoyIf1nH1/Eo5XJtInIds23uGzIuqMYuaTUMELZr70FrNPjRCgzw4RaFCTdMls9uUISoivAVQ
+P3rG5P4D/W7tqDFaLdKi1SIRrcZ43rRWf1iZFopjo6REuHZBJlAm4lVaCmIS70agyPCsxqtUeWluhfwJ
+SpIIXvN88yoijFJNYZLHZKKOxcE7FXioBSFuZWDgO8rJJHn4y8JV8A73I++3g3Lmc8bZjfORWKNX3q6j
+g6romfLy7W3vGUjdi3DyOSsFqrHAkAXvHe1LYuf+rXatrgCxlLaG+5NajK5MJz1pUsruxPVQAJMtk/QO
+GB7mO0WFDNSnPlmmSbAoUvW0+gNQD5PTcBBylXWBrh2Rch0Zfy7t9hOK8MuT1bv4JWbNxPSYYBxzDkh+
+KEynvR6uFe+cAHMW8sH1QSsA0P2V3I2gSzRWwX04LaUxRwjkDEgwB4rMrQiHKJPQVzKlc/Etcg+BhC7l
+Sk6HTJDO0dGK30cJM7iQOq5P20ES8CJxAGXBQx0Yc2fq18oPCyz63GOXexNHUzF4knt+MNiQp32Buh8N
+o0gUZ4jhlttiOC6RExEGSyo8mE/uQsqtFOUWJag7RZAT0C5DekL5upUp93ED1MQKh0Xj6EPDIJeW01qA
++b5QIr2641KMROYX5H2I6TUWXAepukqyvmWlbL7F1lsk+k0Hqok1VAd2qRGRaA3LG9ta024BJeU2JizF
+UaJjZE3aJqWz9HLcwM+5ijPM1YXX/x9zb9/ftnG0jf6fTyGirgKYS4qSnTQBDfP2S9KkTRwndpqkFONC
+JCjBpgAGAC05Is9nP3PN7C4WIKSk93PO7/ektQgs9v11ZnbmmorovsnG+PwN57i+1cWjiI7qroem7Lb7
+AiddV6176c5uPGsXuN3AtXXc+EynnkYgpzMKrhaIk6r3IBi7zpMD2RVwgum4C2LskZCxEZS3jNPVH6V7
+y/dwnC7Lq3T5AS7BivwcWsattCbZDKoDHkyhWGGoiG4YvK2jy7KdildX8Yey41sqHiTqXhyiuv5er1YX
+ScNVliAS2Gj1jm7B71zY4q7dJdcUfZRjSyyjJuzjtAI1mrARxRSgIDN/r3SiQ8puTxZjDfFf52cA34JJ
+0vaeYVHmddvxjvEIhmYEfBgcYlRoT5vGfQ+zz5txobwN1nBy4kBGlYzBWtcHWx2RwHJm15GhpMuPd4DE
+T+xETSCTgVpJ6hyhdKCn6yQqhhggtd/PidvPJ+hnIIuMC+nTiGEFwTTzg9vFWYRRnx7/mswondkcKOSE
+37E5BApDM5p1uWiTL+2uSidF2L1qm/GpXmaVM+lpeixlL4vab0fKkOE7ddWYmo1riT3YT9UAlT0WjKE7
+JksR4mw/FqOjsAHtGEd/Qk+zAsEjngnx1HbM8fh4slfFsGCdjnKS60knex1MaAaDVESItVMEhO92coXG
+IBOPjxm2s4yMTwwib+zjxj6OC309AyetrfYjqO4C980smZjK3UBpWFZMvr9iYj5Sy4Arbe2F2rVHHrj5
+tAuCKVoDirCng0I0W96EGaSJ0glBQj3RZjVqzADidWjXXbjMysHBo1WavTt6/IhZSWLD9K9hzY7ijx/H
+YM6ErWHr4Ohjcw/7MdicLFrcjhvAh2Bxa4zYE4lAIdZwwCBlK3tNKVjowHK/wULtw6FoVDZsY/diaoUS
+OmHzWyuizXAZqVXqMZyXJZuGeESnhsfr6/ESDihCgHCO83U8T4mEGX7iCc7QK6fPKQXIPQezQEEIFOPA
++ukiJUaSUicRlEUWLt9nRC4Uu4Inkah3W235s7XDpfgX1eXqVVKk8Sr9PYl6tybEYLvpuKXREbXwSOvb
+t3g9joCuq1ix6EVeXHIZi8g7ipkY7uTQKbruoujo19HwE5u7dK3+hmjUy+zYgyrtdDwH4Ssm2neAl86F
+M+SMq1dGgWdjdXnoQ0LLBtOz19ubArQ1XFK7dQzdYZ88W9GiJo49zOL3NPX5ByO3l5w+UOo5ojPf2hsF
+w5yay6tIQROPOjZ5isPhBURV38Qf6DP4GOriC6L/3/1UxGv+XkroOr1OVkaMIkGCqvCFuNNhB5xwpo4y
+GWJEQopkxT5cv6WNM81+gNRDPtBqfJX+TjPsBx0DwblRq2pk90yH5a0W6biqrLW8OBl1+HMbsLEfGe9I
+Q0EseHy1Fd8F7f/t1hzv8v1u1YtONCe6pSPoW4oUsZQk7xaNKD03sO728tJ29KxCwvnx079MfM5VZlY7
+pbHll22z/TWrd9SVbZmRj1kXH9CxcPemPBDHrRxgR0H3u57uMitX7cFpvFl1cpt40QSq8RdteJo5sR7v
+Grg07fl1vMPp0C6WUvlaeWPZ1J4RvGTWxYEqYpqxJk3r/JlDcd7rL6Wrqumy7z3dnNH8KYncmTPKLqza
+ayHbdA51EJk2EBWMF3pbAB9wXsBT7LNVuo68uWjCDmjme3v17k6CzXpOO3HBzX7Fm18jH96Su9La9h9c
++21tcusqB1dOuARS122iXR/hxLcQuUmLNByNL3kF08NZXkCpbzSmhQXA2/AM+wQFXw9KXtGhU8Px4DL/
+fXDbN0FJuO2zxzO1+2CQA4WOP4hw/OwW+kJl7bPRVp5Fr/Qrklh6WOvNLYzPiPChkR3jHB2NcX6Gg8/p
+PzpSpRMG+oT1WqslCxqvixbxYmiWqqB/C9AuC36o5OkI4Zqe8e44+itAVeR8X9Js258Zq4yZ43UEPWTO
+I18uaf5/xb3g5qoTYA7mbNjXDJV86v39K8b9+I4zK6O1GOLsF9DoD6yCveGxM0Gq3jmH3E/7U8j5anoE
+1JDuCjw2J67MhIet0f3rLROCPoDRB9q9v9IuGFa6Gb/n+eXkBn8BSwAzU3fzside9JCXrXTMTygdXCdG
++1l+SYdHsuCVDmeerXPXO/4rFqy/H9lfcF0Cdi8PwK/OI9ajVvJl2p0ZSIdwXMqL35gkvpNolYuUPWK0
+PcKljXHpUAQmjMvii/BFM4QX2y3UhONsratdhdsxTqGgEUUGvXCGD3hpfBrdOU/LviedhNnkTjIzs4TA
+GstU8G6nuB60Z4Ityq40nqReaydhHDINO9agyRu99on02h5F96DXLvW2ClJXuJM7OobWVAPyEX5IIqIK
+IvEtAAFKFpWRCDzzSAAiPtr51Pss0H/KPrZPb+DX+9Xs/uluezo1zzNgHr+kCNMng3/D53V9OfGDcejB
+YnLxw0FkXlzBxNzBz5XrExzGrIVmWZRNtJpcC9xmmKg5vUH/LMSfw0M2yJtDb2/OGmLQ6qRTfUG507xh
+r5J3+fCab7e+yRHu1mFzU/snCUti+Sg7ioQfindDe0OVsxux6yE8X0F5cM/hX9bp8C/ju98J52QlTniD
+3MHWuvmJg9h0OGYdMQX9s5hD7QNrMMaRvNAy1k5M4ik6TbvMorGGe5l9V0bZhG2W4ByGL+Bydn+ylzYI
+QgpVuaO4+JPj9a5zUPn2xx3IOMqdgSzpLZnaMZ+F9pHRhaFiyLf+rAuYTRAQ4o80EwJP43e0CiYVQ+iw
+tpF2SaucFlDt2eFfQfGm1Sz04Xy4bp/AMEsEfK4cPxOBq1ZRK11qXqSYVtN0xrof2aT3NTCJ2KmG44DQ
+tyqZO+CXGEA72xL1tbSVFSi5f+AggzsSIDdEYIa1n8QGp7Pdxj0adfFtOXHy5W7SS3q3q6Xw0vM3cA2D
+/EPG0WaldJVcniWs1i5TOfTmqzJdhM9P/vbs+dNPvxg8+eLT54Pj4/ly8PmnTz8bPHz48JNPHnzycET/
+eXxBwxl2atc5oFB68KfusGMh12+q14PN69dsgrJo5tmQAP6g381d1fN2XBvzJ43B8uZPZKfYL+ubP5Wl
+xK3n/Z4jVxfStteEuP28122s0zaCgdRQBmt62w27scLvwU5Pu3ntNIF3YBWV4/3Yv2n3i+tUSg6BOMIV
+rPZ0XiMu3WjvG/YCknaOa57UUD6BRLd02kwL4418U+JncIEalvDgqG9/S4c1G1vTVH3FGxW44QUrrJrG
+8x4yHXiiWOMua2Pg/gkt4ntUKjWHAWjHt1SEB9RqWmkroT1fruICne8AHBJRN1scjMPYs0MM/ccJxay1
+nKC2iTJdCedyoovQPddb7sH3CqgT1fXbud5w7hlVCvbwx6dHE5PZaJ/rru7XDjpfKm8Ahc2WtRZn1JqF
+acfpU2jHQ5FXFRsxQGCPOuLfUl6PQw+NlzcG8+gXfU9e+0X41IB30O5iHZb6fI1jHWLudFdKM0VT2XWS
+WR9qX3e6iYUOF7fdE3pib4/nuzQ2GGPagKN1KOLXW/Fvm2SThHeqsiQwyfaZlLiGuw9O4inM8TemNQHU
+Lv1eKh5+zcXDpBEHHl6NM0QcRkbLkBVnWVEOIJqLZK9KcNnJ1BOVLwodlC3HErPuxj1PZo1msAe84Whf
+5fm70piAN+d8UuezG0M4Zy5iIyHi3QyBLA4rAB8VkemZWfw0Ny3x7AYtFgofynhQI+ISJgM9aBhAwZBV
+Avj2C/eDTl27fUXqrucYXn0LWw8Cer/u7hvROLj9Mrp9DUiJnSVKJZuxxj7d/CQaHXs7+P5sMmolJy2v
+gLVPCRa9wB0gelX6uHjc3rUmZsD13k97R8jQ6byZhZ0bjpxhbkLxbtmcFXonUnpMqTruSGL9UHE4/sxU
+qbeujrn6R1tgZyarPeeglmah3r2e4A/0xYijYj0nEK12QfCdqmljp0aP4yoNZgPjjCeluw5YPGjiFBjW
+neKw7+9snl46uh60frvuuF3nlse8Jhv3qnKF7vrVIp7LqVrHNWisGJRhfMdUqth2X+8YNSpCYbcjEKYq
+aSwo3sIKWZNY+P2+0m+8Tla1+dDKdy8yMz7D0MTf1c/qx+hoelqdFqfZ6XJ2dK7+FR2dFvT7yx874dnK
+Ec++eP4h0eMtvnPI3yWk7UKMv30f1ZR5615O/dP5xqWPGysWhE735LN+KnmIrpkkUiA39ykKS/w+aeb2
+5wgCJHIXBHXs+s9VCTH/qEovm7k11hbSf5ley6JSnZUEVcB7Du72zbauA2pfF7xiaJYwInTYgatnNBAt
+/VpP9n11RqKbx8zLNnyjBrf3JXT6uE94S+dK+FpxgntKdMbqq1koK49ZU5Bpi0gjYjRUM8dlrdMofu+g
+X15EbUxugOKafAX0yQkQ+CdDo/3IlrIhc7M3uTXOSOGsE8YZo8cOyJlFjwLb3Y/keezkbTy2F50qg/+b
+kQA5355Jtczm/5vxcWr3f/MQ3TJCGkO2Y5QeR6MA2LLWPZz5ovbGDey3HjkYXTUGr8rPz1d7g+fQQHoI
+DDVxJm48G94s6wGLsokQCM6yEK2t5jhAstH0Q9wxiFk9iE4tG4OYtQZRsQ3ILuimTxq4mVFmZmjBKsa6
+KDYEa4y5MSyOxTNYDl1cqUkVTPJGu6ogzOuWV8JvsMeZFAgVtuvYT4vfrDoonjc1R+i9eWM/vXnjtSdr
+6z1qvtLmyreacJJ+V641NJ5pU4eqF8NO8sTSRnNtj5yOR3I2usayyGbuckAWJvjOdWDneSXzex92+328
+6kKEZImFEVOwCVxrWzGbRdpShQxU98xj2fTYtMdtTA5ttcYcNLOU6gbF8cQIVCd5RAOw5/+VwgEZGNaM
+Wx5oCQrkl7m62+s9nN4Dyh60FZXItNSUK8nGR2BJmsG3SI9AfXlEqnopW18XuFGXFuXKKD0I0yszVbQf
+crNPWkP/Rj3yjkrkd9bg3NaAARjo1a/L5wpMspAtXEWBokOOXQsl/gV1jlA6K0NngVZknsmQX6ZW4Y0o
+bIU3DUCmpOZi2N6MESATW59xQ320YhsXEJU7gxp0S3Y4BSNoJCG0ZIPmJlwQzRQJGOCK1sG1GT1OWXrO
+4g+21M8naf/Y4rfhFH2cTsqQgkMN7lK6lsTi7tzvZbbEw0MYkeI6oSZUHe0b6k6r6i7tjLJbbXNoB3F9
+xNiUIr6UUffdGAqKctB4WHvalI36GnfwvHhUXmvjxUZx3YJ1o5Or8BYvyU73ykoygg+WQaT7RnxFlOL0
+hrqYUfSqrQqu6ay0VcJWxNOzN6pnAHVfaxCjwXGAe5KdatP4VsQjt11NpGfaU3C1Vx4efiY/Jz3H72En
+3ClrzAo1bczJeestRddUDIpo8jGoSRvTDL3DuMq8OLMZzwO2XuJjb4hjSrtRCSY/h7/zVoNlmNerlR71
+1PBjUZhivjOYxEAMb64d3DXygoxpucSBmVMF51fa/DijkjMqkBWvfMouaWr6UGP7UNlCkxv8DBcEh5/d
+nFFzrrCDHNhWmpOed7Q94WfLO07hcDDot0zd3nH/PDz8frvt/d0GwJyejmS+86gl1Z62kINoNZhFJtK1
+7TztcKXt7PB72hSLQGaa3s8YouZmf4WIu3ez0GvFNOp90VcTwZqzXmmRaqW5Gp6uATCTdKq/VWLQo+NG
+GI7dbifsJXVZeAMFSToPoRX5JT0pDdAbOtrD+8yo64Xuv149MWuhl1pua9cF0FH9bG80cx1iVoa+UW1P
+1LRjoqZiTFiE3YskbSwSiSxNNYOnYfT+5HFE0ZlSqk+kasIi8K8zyJyOR0H4yx742nb7j70w3BhBmXYy
+CtnP7Q6utfcmkeu4SQjLvaUX7k/45iTpfc/gAY0Oz4I/Wg5snqiy3c5YX+yvOHEGqVfy0elV/+g8UF0S
+UZ3UdarAhIrt2XFXjGivYY3JadGQu9Li4IFZame+Fc2cQufRaYDqGHLup6a1sAvvMkG+o1+7zV1p6KWp
+vnM+6LXcnhFuaZ0bx8RvGv9GGbyq/H54+LteOnwdQ0WivN+78ze4R3sGvTW6G1BxGtOMvzMt0PA0LGpP
+9WTkGxCzTwl+t1B4gtabRXv2yAWNUVjJPGyOBlz57QdmIjBpB8/zvFg0HIr815bMnsfuIbm+1pRZLglr
+onsoEs6otZ3Ui6Ez93ENxlQ49tKFLqQSAoyHcKfcWaIVQJNFKmABnTuIGXpPwMjpmNP2NXppTz1tWe+J
+eqfXtlZu0i17hdgpqa8I90kHL95UuRAPOJoCxx6mZXiAfUFXim0NiO0o5s0KsV1vfVxUs2hv7+52c1jB
+Y0PDGMfYpDRWnlhN3J5nQ7ltu626qOPuyLjY8poVcMwduBq2XTVxfMvJlHR49apYC7LJ4dzhjLgRUahF
+zbTJCJjDERr2Sbz4DhDpyruMr8WBCAiJZLV6BXMPGGvy20vR70OS/Io+ZQjPV/ppUybfxmuYjxa0Tp+y
+kilH4Gn8hZ7G7nibwcbhJcx2g5VlKUGjR7UViO1NSmhtRzx6yLl6TiuFGFOeMXtql95g6vdnf+ZsyEau
+wKewMc5wmZvEMDcZmBu9r5maa3MYrnmzUMzj6FbpxB6Eg2aaJ9DNDzVZiFtMvrv59x33M3zHQkUd/fou
++XCkqkriXuY0btu5ILTQvr4Jtmw5cKQyHUObCPDPlv/mm+pstSmgo1hwpOmvw9n9ANqMQ3/YD7b0pdaH
+SCvXiYYNzp1gx0l0XOn7CkNtNvGU9W1FgtsKGmU2f7g5X+Vn8QqqWA0cAdGTpENdllXDDE9p0Cn1vr7c
+Z4blPVwJXvDBAvHJPCqIU5ubECI+5hatgp3GblIsbnmIjKZjoPxV9F6qB9BC500AEpf0LlkGDKlv3rom
+guZWr0XiyTJNzgri5/PzpGA0ZBFt0CFnPkJrluk5MTdeDqnSl44V605JUJRATZUVI5qCec8DxES276i+
+jLASAfuZwVE92wmeRx8i4Aupy8gvpyfa/Y7W+xsadJtAnVOXriNTRz4N4xX7FaDBOyfGd7JmrbxzoizA
+jYTrIYhyZhy323N1a9pFregpTNu5yql7OI8PovqWKz2IYaEwTKEMmjLDGcbKRSsKY+7pmjDehzKKqeto
+q2OjwNC4gKTW7tScxvgiQu0wvvLE8Im2dc/yTQaT3zXO0w3cK8iDRfGn2bkEH9Q7ZqkIzWw29vkmLamV
+CZAs2kEMrghPI0nToihpGxR5fYoZ0Em9Rh4YEvyaktlYQ3eVmd/NgEh+oEY7ubC4hM2m9ftqRFmFFyJx
+WvCmzCMnqxUdAtGPtjHfB8nYB9VUHSuYhl2rS/pgvJpr+ZIX8KVZh1DAYbTKrole7U90xuQyc72yc30R
+nctcv7h7ri/gyKQ9XRdmuvrFnVN9oZbRnGOzfLJkl1WOT6DT02Hg9S/0nKM32nyH9+l3G0C7yccTvASp
+VZRbmDHdtpzaFkfLKfRD08PDc7abNKuFCHUoBGF8OVwmQAl0OD3ph3bOQyWcqFqOZ1YRbibu3/fETr1X
+h/M+Z+ZKDqASN82yOXkGA5qaMiGwMOTJqh8FAUydestaUXJNVYuLBfEniG6eTYILdWk2W72eDKctK4Km
+fB3D3I6j84PaE9sCqmvzwAynJEfKPiYGT1TM5rYm2xwyTp2jKWJPDcmT+emxDyu9qdfrwIK6dpxh0ZRO
+hBjb73tprEHFYuYXkyqjJVJ/syPHEeybM3nDKWukbqJllOK4idUD5ozqu5rPWu+9TO+G5/29gwmtP6/v
+WYd8w0phl9G5UyZV/9Kqp13q5ROwEN5JHOISXW9eWZQ5Ws7ERAK0+3oow3mu9i0IaErDBC4tX0vVonxy
+Ej5QTh9Ezv7thtNQRc7rZG8NXv7hGgzFQSjUf4h1jyp4k2SfFRAl68coZQ1ApvkK9grf0DNULDm79fSD
+a9a16XYg4+hHffgD9ZBnvlwOAI63B388Ys7JusRp+RMrvvup1h+eR83tCaevGel5/xzLeRNtXC8d4824
+FaL3/g2gXTdjeO3x07aPzphGVcdbWulGmlzBVYZkJRUDVO9uYS/zqfCL6QICZMxAqj40ZOJz9vDxqsrX
+a2hmBbIIosXj48nc2V/RljLyzWGxqVcg20ZNJdmsPk4ohqxdmhsOhsuG+pUyWsFeZjUTJE/HcmMT2KjN
+NBpICyAsXPBzabZo+uo6nyseJjRNf34pkdEwaKmuqW7yAQNunnUp2jmTKSto1SylAKp1SvOnY/SjFK2B
+hyY8iOWD2ic6z9mMHHn4gSbLP7BycDtiVee1tBdPZjnsGASKSdWwiZBmMlqm1/4+BhqoqVUHXMqmHle5
+nW8ObWLuUhkyrb2c7NebHautiC+hpF4IslY5494cw/dc1327bbw6V9n60AluSlucpqVKN5bagA630zun
+Iz/T0zu5bXrf0Jm4KbBGdMVyTeXX+UBIboqbxk6GX19eJosUDkK7cvZ7SWMLhEjQfZd9IHVIAXbSpIui
+wy9K0W0wp0rFLqeIfL/d3amlOmZilCjJ4UxP1znQ81k3bFXbYyVmO7XLiVFwmutJCVad00Q/qMHZaMTy
+sjJDdnjYfG8MoUrq6Wq68zYjj3p2Zk2qhuZmord7nl0AFHd0IKjLtQrl1hOT/571dsL6U+NNj6dea5vd
+bllTQmuKNLKsIR96grm/nzFv9jnqi1mzsvYhmWhhpZZIY22VHHdpMgD8mDa4osk1nVSitsHHNu2AkITI
+bYD+pP1Cb6xf6IAzhSK5uLQIxrkl7ErtMQKDH27qns93zj6ilSVXrehcmE1hvDyxWSZjrl3vWRg5JIV1
+uDBuIqYzBkkeJUbrjrIRUU46G5dGqcMJjCiePjRTrY/FAhe5oUpan94lH/TVFTCkopJlXKV840djlKdf
+tUoWPwOsyyGCAFcRFU26n8jqALoL1UzJdQf9qW8fLUliHnEjV8y13AUHtRInSfLVnWY2hf3oEAEKKJRV
+/M/kQwSXUPpZlRpcfWIeGPgrTOQGrwy9eFVRvIMzgZw4mMfZPFlhLh/Mq2KFT42974BX/ktiBmEawGUc
+MIpwstARmMJEsNTxoEovk1dVfLk+eE/0BnxdzC88x1ZRmXGEYKkeGl29+QVNDPx5Ro08oM/4h+dWFuyR
+tlOAbCBauWDuRX4ybiKGJvNJ/RhWQ10KnPnulDOXTL1kCzmQH+q2VUrd8rP+/eVgWeSXekgPxOz5Z/37
+ywHtkcnP/PeXg3JeJEn2s/795aDKdao/aF5jIyxpB5SK8GbolD1u9QEXba52oWbJteZ7YTun2oQjrcPU
+OqbX+fKOBUQMpfOsM+v7bK1CDcpXq2+SZSXMayNgFAwklqRxYrkB7Nqcu8nm/ksj99f5upE5v7fyruM4
+7yPY1STDxrxlSs1vBUYrZyny1aPugHCFyvFEIq49ElUzM7OOD0uAI9PfB+FD+nsSjmQe6QM5vFnl8QLO
+H4UzYJfd4u3uZo8vtfaKYP8r0KSy+SF64Ahw61A6j3vHrgBXNeQfnhY0ezsF6XJnkU6eUaNYpJj49pGL
+0pZYe0Xkm8qDaQidhHcV4tzBCiWpr2GJC7XXCUaVUaDYtcIo5Tvx6+e6LoZI77p7cm989bgqgKxRL50l
+dEYnm0wGx6VRmhSzJlEsgQTiKs3i1RdaZIFy5OLYRMWVmSrTy82qgcOpRW/mtthKVp0DBpZZLGBIVFq+
+0jmIybNbagijgnExaTEFxJEKHEKwL6/WnkGAlHgL77PPN/FViyPSiWL3zYpM25enSVcsyEs7gtkfEI3j
+Lux2ncaSiWoMXsEFczKqZkI3pezJtDA8VSOyz4ovaIg0ofMaCrPqIM3KCqchLgUk8sRnkEm+ApCZ1xiF
+SJuFMGOpiRgO2O/fyKoY2CDQ/u7kEREa30u9bIyDtpR+2RqcSVqFeaVpFakCdJrsrBKqUOsM20M5khaZ
+V8jsMmJQJZpDqTHQWdAQALFhn+lHx/XbzX5zqWKqi7mS8Dt4JERozsJ9rNn9gRjf1utppZIODmayz9KE
+zcHAjFQtHue/qcl+y+q6tLI1yN8NbkpBLAvKTE6MiK34Eaur79yK6fLv6GJUhGPtFVqrN9wwBYQ+LEKP
+n2ndFp4QRqskfp+YYN7z25oGLe5/Ft00jotKGYkRPQor0aUnL/KANGqd0YzWYRliTfGwdXEqkOK9GpTe
+Nz6/ZHUS6W04Y5XVHHw3dLDSaaAq2FaBYMQ3jdomOqlui/V3vvberP/MEShojFAzsXnBrFA+8mk3fCOZ
+ghxew/rUBHhqr+ssBcN697Vqsz1qZdXXwdofKYTXqElIdKSDhFAor9Fgtrpyq2kjdNcpMcFvzsxk5t68
+JXcI/BlVHdZ+tx/KzTzr64D2F5nrrj4Hy2m0wLq+9TXHte+ZNrQSqkRqpsw9yP9yYPUdh3y3PcbtbUwy
+ARO8fZLJ99sn2b+FCW6YVQQT3++msrZbR8PWBLYHWuq81i7WpQLDN/LbHHOLFcn0dJNiMunFkkgq+Obt
+pqx0Tgve7WrR6N5K6CpwP5f2QHcWdFwXU08Ak7+WIHJthNjs6A2hIlmZghJ3V85oH2khlRkZV7XVrrZK
+eY2R31ttNkJ3Wb3WtAVJ4ZCS8vraXGnc3fi92e+s2716uuv2tk297gOH8ILRxt211FOTrTuEJagnsQ2c
+OKfC3Tt7WP3BIu5cqLpjaCJ0rqzm4tU8l1295lwVrs+yZMKRuexTpx9k2sfdrtwbtErZHb8p3ecxG7cP
+5GrWsWuw3/h+XwPAN2n0RK5hu3sNCQeDDAm76HuTdg8OouFZWzWuY2PFgGn7sDa0seyBpDHyVsEq2nRW
+1z51k0DIs8yPOfeEXWAF7syDWzh9NyhCEtiqANUDd4ZRZayzGNWjA5IsZSUmiqafpAbA4uKbiTTKq7Hx
+vNpLXRNgUwlIlnN2cJJSqi721Q8Ao4ehvMXDwY74OVYeKY1SSdlSmgrULRb2ra2MWE62EoCNZZ518a2u
+6MFRJQGtTJW8zRJCmz60aW5W5zcLtjZ5dALVtd++I5LecC5IJvVlR98ben3nU1h/UrW8XdWXINwvt000
+lt+784jKzaldyTSfNSeRpkAzzcHdhqln5gdbKPHFSkET5A8Gx92DEjs6e8IVV/55Z35GUJBom3I3u6+0
+GleXCrYxV7Vq3/sZal0NKGjKrWIJHcbh9NfwL6fT06Ga3b93pFZa+VEOlnKLKUGvP2ZVuto+IeY9OFKb
+KrpVQUzNiayeAxeS0jPksqjBlnjOoF4GB+iUKWRsTTALXJZ0WTtGjMeveQ0r2nfsr2sIkYYVP+5EiIee
+vxMdVS1td/pcbPNH41Q7WGBUgJozwX0BD0Htb1mjSruJ9CUPjT3iO64Ro1Yl0sewdBEvTOxzibGJ9aSX
+yOZt0nhjr9YQOGX7PoxMF137Qke7cErjhiDy1tYXna3XnjCAR1W3HpB/1a0IIXVTl9r2N+HrZiHK90Tm
+fzLxMRKnXZ6bej0TtQvkYVO7XcTwhzY73TsQhOYlxei26xNHmA7AhL4qrLPtwANNJjwOcjE51LrFgbGk
+NY408aIBH4rZGCo6kFyOYVzpXB/hWvP4cQMIIp7E+n4xYy9VbaQIbX2kPUK+0tOH+McE1rHgp7W/r3HT
+p3yz//Maas5O1zwIcwwDW5V1+tDqcOvm+OzSsE8YCEZrMO62hm8p9+LDBM6XQz2DQx1bxMrAj657ZaLn
+clHC6ya2EdqSfDOksDpra0JbGq2rgvy9oXDEx2DtzzuhWolx/GVCp5tQlLC9C5yV3t5sTKfBHRqq8zR2
+vXm15j0Od2OPry81k/eiRBe23u19YdDE3VtrV8SLHDCZ8MMk1557gJG2xsnOkLvStX/eEuMYOdZ3oJNK
+Gwvp46JbwL9I2c6wzhCawjoFHyy32Qd2JWUZJx8kHWVxV3j4+io9W7FNxE5Om1sj42uab0onAdLTvLqz
+MR1l/FGSW0r6cz3glsddgNz+ZOe1yuXkpbzcMmL6K9C0XUYVqio10jQAn5Q96u/Mx3UWikSGJviD+yDl
+pWxT47HpiSSqL0JtkOhw2SvR0KxW2rBhsUJlovolxKdtQeiSnT25Oqf15Q/j/wq6j13sHne56JGIYsXg
+E007BoKB0Yam1Hiieu2yuFM55wo8TPvzikGsOKLePBBtZc8aziQdgjwvYCJk8nCoi6AJTdFxAe8Qiw6l
+yFbWkRfSye4zNguUpxmqxGqjHLPTHAd+t/OYIQYumNCJFk5nYTOKD9Xf8yJZN9yd1d56G3byO+yaNHNv
+seBkb4vQ4jA63YKCm7vnZCHXv8eNcJht0zk2TEtszAEzdk4i6zObsnf1Q1K7VDpPlelMzvaE3UI7izRo
+toqOlZ6GnORyEseFtruXL6sGHLYDLlNZiCrdl4nqMlfs9Sqj/l2ICl4mECtVGye4Kx8HX41qWyfem9YC
+BVDqCerWTU/0StAaq8gNscpLdxXteuNk5B6pRm0ptajqY+rCgmxvob5MPX6bWxtRMW24LNCQDGaiB63P
+8L0AZVJnqFDoRRV58dlZsY2LKp2vkm1cprQ5xptFmm/PFul2Hmfv43LL4Or4s0rLaosLz3RVbpfp+Txm
+aBE8bopku8xz6p7tRRIv8MNwJtvLuHi3vUzwIYvfb/NNBcs2A2y5LRPuim25uaSYH7a4Lty+p2rknjon
+Du7g7fegq04X/cgj7g1n9JZeAu/oXF1WkdHlfkTfvP5FRYt+enpaHj2eebTlUkd+ABd4WvaP1Ht6omg9
+QTostvN8tWWU7+1FsU0vz7dicwcvXahvvKXzIr4MfH96ehXO+sH018ez+8Hp0eOj81SdcWb6y5G6xis7
+BjtK1RVetod/mZxe9cdH6rWUG5bzIl1XW7FpRSkBxX1WOXCKZ/n1lsWPbOj3gj5p0fZpeZ/iTH+NZtuI
+no3t4hA5vEMO97an8ETwNn4fb5P5ZRxIYfT5C3wGljBFGN6nqr6SDrn/qAdjv+mz509ePzmdbgeDYIuA
+2ekMz48pxj3q4ifEA2tEoOmx8h4JJ3dwuVlV6XqVRB+bp4/hQfzRkXx/7M3UKjmn7VtSLdNktSiTSuLU
+b8SG0GBIHDqj5DM/zBT3vnwSkYl8Nc9gaGmeSQTjZ8azLmfocxFOT1TtpwaDo6PwoxOVZkJHXBuRPvNU
+ltT2zS2L6vFgL31V6PKKxx2FWk0Sx9badSQ3mY4UDPC9Gbfx50fiIMPTnjJmO/W2ihZswfa8it5Wf8aj
+yfgJmzRz/SN5ppGlQdY+7/CARYwH005+lnXO39HrnOKCXxdNSWt1CznbRMy8bZueiCdwv+aeBPjUN76I
+fH+PpdozRtBNh0U32++DyRBdlWQPnxNsDWd8i4YSUWKX38ZZuu707swnxx4WGh3XHWGft4PMvv+NERLQ
+EdMYw0Twb4nm/b+ogmlWJkX1lC+icKo1qGFUV+6o/pe13bs/bQXsFW/EivGyulXL7P+PQhv00S7oNH2s
+BTBCaGoN2XpuA3BJaC5RmmRUsnRG53u/H2iqL3NGyPWE8SUgVyB2cyvuM16RFXxlzbWhIBp+U3FS5ckB
+4bXyaHnDaQqf9z2Hs2qKqlrNEGUVCNxYGnfTph9b7UhYMmY4fXdCNavT+DS2sGZtiCbjmlT05ySOXu3R
+qAXRym7rboO8NrIMgFNRG+W1mkCRRLTAianqutHjTI0YvRIYSdrY/7tdUaOMyh7HBmJ7crza7YTDfdTz
+ZZLVTpYsEOB5xUiAbLzQ65A6b7evHalg75aj6fDwsjvWnp/Vw8MPTswn1dQ/08a4idjt4nwL4JKtiRsB
+wxhWxpFqv6dqP7p3TMfnvZPHXsBWSw1BpBVCclft4c62Vw7POOV0UAQ48mjkOEjYZXojaB1AyZ2Hia5w
+00u9UeFy+PD9uTBt8FzKlVbMcNMdjcZ/dk+rwWymtP5mUGvihzEuF1lfuc5buwjTUnt3V1NNpGCflTnd
+nRCsLDW5N6LaOej3Jjr0dqEZeau80Brg8nWvadAen5+Is7bVBxaCyHWPNdKBLS2ti3lDvr2WG5ZlNB8c
+q4sIEll13sQ3vWAm6pwmr3/8OJp3CMAvsN82oD/YbSVtKy/0jL64C2nZcvnrYfIbkJnOWW90NItcqyS+
+IaSVxWKQtB5U7ckn0K68/BXV/mxD+55lAuUc2aN/aGKrnp65kPauXCEXlsXKkSLVxtcreMIqtCVRrCcq
+LZRVfU6oryoIFGKz+cwfb8YbNjOiIYDfTeNthne/lAaVZ0aO7VlkWLGiHFPn5GEPxKYvYIKdqo3GUBVv
+4vHUFDc4bjdU6hir3yoYDI7Gua1PjKzeGbsco3HkuYovVAuBMPjifbzygsapSccyC6lgOUPn9puEovxY
+rCQAIvY6pY/sGWVIfp+JEA+vdmcRaAGzkb3i/RfW79rFXOM8csUn31QtxHn3jBMYnqBx9ImgyxFnu7QZ
+JSlY9HinS2s+apImEdqJGGbZCp0QRj+25l9VDTwmVmz0NeJfG6+PDd2Dvnfk9bVSs5PRb4505AtzbIiy
+WA3vZzQn6QwJ9/EgdQlurm+q1u1YiwSDcnfAh4qZLVljtij4lbKaSRS5OZcc6c63lUWabA4Oxq2GvGiC
+NdfwFyqOagWoHFbKuYbBwBopA+P+OTagBHGNT8NtyqBSUAbiSgrEAwNNG+JBn5xNda9MIQ56YCfe83BX
+1/LCB4we7XLOcX73XdUCEx3vNZt7IouqW3CPlUNIuL6RaTdzzfpual8+WgWnQDtTAxHSBIaoVK2FAf6l
+PUFsxsHObEwgHoDEhRUtVpawi/S/gnCQn3UQbT0QF4a1RkeUTfyqRZHXrsqhnGieGwhr1hs6buzsxsHb
+FcPTV3Ug46O7ZIvziWoiOrfSgGeG8JIVg5ppiYNxfl1ZDKzEcYPNcFTc7vcCh+rrMBPCTV5rrRMg25t8
+rWP4GuOstkUwH0PfqSVtjgbbqoZ/bwIkNvESg52975Nd6nUeevLkGU4ZQfrRUy7FEmoFShP6hJlGj3lH
+z5BuuPryHDLO+8OblqR5085i/WtZv7k9vmSTiR9Hhl6FZD92yCY5N3FkXrNdbkD8E/yRXlgAiEyua2+7
+rE2bV6lfVq4pn/Uz4cAm750DoAVvOyIwVPfBOZgM+Lbb3Jg84c0didvBdUJmOUp9srMejHujReeO2YJz
+dmwXsBFzL2voj+fUoklp7jfs7VgJygLfHJUVvjfJWsxhBp9o+kYtmSniw8t6B3vKx01r2TAX2Vo3drUE
+rivJ1b4eW8uiXIGMs3RG62RVgnfUtS2ILzMHvbpn2C/vEc5N08C+R7zRBCYLDXfsof+8uWXYXUg9rxoc
+dh49bwh0aEv2b9uT98J174jYouHqsRnQak2gz6cv2eC5xC8vnZGZDji2MB36/TjAxDg8/A5kpMwR3AYx
+nrueVzSPJANW05Q8O/LC1Pq2zoUVJ/m0rnU2pUYO/WvdL45YilKo3urwkFeZI0spojJKxUVkvlMNkv02
+2zzVgMWijtJczBLCXSJuFww6ZsiU9eOL8YWoOOHy8GIG4Bh2Tx40lAvhlIY6m9ofmBm/ULmjD5LPwjyw
+KqNXekJR9BvuxWWDCqxucZC9iiw/n9/FzxODRrz/agYRgMU5UaUzK+eUpp/fxvD359MT8LFz3PbWPmep
+psNVrGfruAnofYcoAm4lFhpFvy0s/mCbQ0UFDZqEaV14o9HkN83qFU1mm+nE3kPQF7Sn8XEUlmHpUuRp
+JKbEbXbMaZ+zd20iN+o0JapT095EJ2y6mLq8sbQ32KvMPChdVQJVurxL5Fk/Ze5OUDYya3walwAnM6Mg
+cFu39S5Nr3EJqLBGbi4xZGad3kv07Sots4W1KFJPaVVcWHyUPFpML4CKAp+UPVr1g2Oe/ubqNQdjyzj6
+zuabtzZfs/U0p30eOOs/5mVfQl0KNGhdfCko+IbjzF2OMzNnleHzStkcluLXLuvwqGsIaAEhGfGhYbyA
+byLtMJiBbzp9INO+0dLB3+NvStryStlDfOZlHFihTLwuRxnwg2JM0Q2UkJl0N/xFYInuOoQolklLkZj9
+LzSpcbQrttT4R8i6tqbCm5obt82ogKE2sjbZzsTGXqi/CsLMwB2ptel6KMsYLv4WIezb+Nq/2dBnGgE2
+Mv/7F689hpwUm3M9CVRcfsjmYe9YabhSevKqiyK/Kr2QtUrbhgdXRbxuK1P9bz126bya3rqM2xktDbK4
++LcJiAKIo0ZBTW6O91UTwfw0JH0mc1W1hd61bWqH/N74jahDalYwaYTX2nxWzipXOw1pPTrga5wY3aP4
+h46zWn3JWe335p0+PLUzrEycq7Pmlw+nYto5aFYPEuXjiIwDqX2nB4NGvce3T4VsfypUTY9LQShFbbJm
+YS39ZB5oP+hQ3G9ZFMoBQzuElQRbtkiDIzgaaZQftdX6oHxZqR8q9VOl7lXRUbxaX8Sn/vTXYHb/FEoL
+X1NgDmDq6sNpeR86DfIxOFK/s0ZEla+3BdDOt2d5VeWX21WyhEdK9TN/zkAW82nrT3qD+TQhMm4IRYwf
+8fkyLs7T7Ej9q9YP+dX3+ld9L4AOxD2tGvJLx+dJb30dTOPB73+d9U28f7jxpv3BLIh0dB3h71V08/S7
+578Qg7nK5++IZfyeQtZ5mbLihBeflfmKNihPvU/L9CxdUbND7yJdLJLMY+w0eF61if9JiWkPJCJdY3eH
+I7Wk2fYTw7+HD0ejnfp3FU291zmwun9gUHjlPeWOogdAnHgzlWQU5afk7B0MTL3v6N+3+e8ACC+9Wc0n
+VrU7FO3b2Xoa0ndQDF3zpMKuUeU/0pTWRF2/0nqDx6B7K1wzZPs0DHsySjKiWPqZapew7246a9/CQTtS
+eZl2/3QNrHaQ3LrTPLl/uoOpqrMu2u5ujCL8iL2nuK7bjD9DXKEAQ0tw4hkxK55FjjlxvlrYmij46RJA
+eR2mqgmnIGKAGyDWh34rVgRXNoLN3/xA5AMuEu4uVq0oTm0aSP+FUiRsy5Bc+cQFu+X3Ugdlt5UZnaqh
+9HHh9HGgjUXASd3aNZVTyl5DvI7Qjp6oJrq74B4OeQUNRW/nYJ2X5W0o791Xqi2OK7rZgboy7jMNLrzB
+UvuBmci0NkBJH5dMM8XTjOinmZ2IeMOlS804xtaJek80WriJxh+VTUfbNII6HcaWF/lVx+5NE1hgC3Ff
+RrtHcnucwHiw7Dgq931VJhOtsY+CfX0CogD/ltMw06VMzMmgE5pXSbtrKfJSy62POd762159zD5krr9/
+Yu5aR7Y+fTwRO3rHXpixIyXK9wX7Egxv5rTPXmYM1QfrrGW6Wn2ny+o1NlF6W6VZ8pV9y+to7PZAHujY
+ytje6ypdEJWHp9/FIRGe8vyS8ZWoAi8ZuuvGW67yuPIcnS769iXCJp55ornNU0JeALXxYZXs2acKnp14
+cUpa2L0NZVyZXw2E7cj1cZMFDFrIsVhzVOYuV5hZcr/xHtGBsFGrAAwRf6j9oTmvq5k4PzNbeVm7dyqZ
+fyi1XydaGqn2BZWHG2gjg5GwKuWOvVMUc8J/aAZcmLYior3veNY/Du7n05NZn505ccf59UqCiMd4k4TI
+SpvZYtc1LiaReVq+iF/4jGJtPgD3SrdLZpB0SNGPvPW110BIgBdylnO9Ep8knsbHZp3eGuIY7u2hNpct
+PMbXBUgf9cpFUjDwQlm7zCq5eaV1mUXdxD0aMPIWJyy0PsIc+gg8zTqmye3jXgtD/3DE9fz4M+PeGuq4
+HuoROG0aCwGvimX5ZmwmQLs5XMjokeCLqX9KpH9WjIvsaRdDBZ2YkTPKsbZhHYnYkEaJehLimQnkXvCb
+F2OTYan1s/xyTVTWgkdo4v/gADBVTaczjZi49gKW046o1eguD2tUPTkXVlE5KQUnSeAl+G6Ceny7RY+F
+lbPi6gXie0ZkdBe9wp4mnEMjAJ0qkoUVrYgfK+Nvi+/bN0P2DUSUzGZ4mWY/8UuMl/haXupwJ9Ski1Zo
+i87DhKVumlw5qWKI/IhDituYfQbD9pWmBX7odIeSNKL9l919S7/KWgcOKyQV2YyVGTY8pdxu6/3e7jfw
+EwzvU9BZwRBVmP+xCOX4I+9H/Bg1a85htKvKNw+nyqv098QcS8mlF6JfN8N1ep0wLGEf24lJkLo5o0t5
+7q8m4oUpXLm3OG1zl38ZVwa1nHrybVxdYIx8Wn+0Vw5w5TIKgr5fiGcD7GRh5bhn2XfMEOG+xC8mRBqw
+y5/QuPzxgsnDUPufYgXdY+JFYiOMfvg4H+f96CTwhOPSN49+3K/po/6/K/ZSMOJ9gJa3zdpEHtRU/Vp7
+J2omMrn39hPo+kr8vveTOMqSdEHoVqQz7zq0t1fzu/OuqT3br2VrrBAzcvsuGQpMJ+cUmjchQZShOWNH
+sHeWX9PEourRliu1GWgsHaeSOoqnqVA6XkePAfuv8SSYjkhlL2Y5J312vmp8Tl5T0Ni0qya1Uql0XPDZ
+3FGvH5JVCiZ8u01Z0lNnk7qbeEq72siQxWnfTEDa6uKuKQfhZ8BLpu7clSMyiYnT+jvszFyns1m0EQpS
+1bwOnTT05WUV0T9IMbxHYoR3wH+l4Ojj0ccHsvHhSVys4fHosRdwL3vaPZhnOaKQOfWDXnqJ7ohRZyO3
+ep3jAru5O0J7j2oAIVvDzG+7bQTWIjqTXlXDK6IbEqp4j8JANB3gWvDxI/krJg2sRgUDcJjy2l54WWlk
+Qvhh4N6CXV9W9+imYf11vXfHkzTbxJcfbD+MPsmgU1cziGMHEV4rCxa72lNcoiUUshb23dc51MaeZz7e
+pOxeNxKMp3oZHR7+rCfsvmgAl73lVQx1uu8rtc8rlcZVJR1p9jnscBhX2+wVh4e8TE2DzfdJbCiy/4PV
+C7zMIBy1neBpzsSQbeJ+TgdGHRyUrtrXul98Rj9xz69J6zjTBpbGG568BnyDMRmOju87K1nkYMN7x7Q+
+vbBiJszzbnVAbbYEuex3ylTib96Qc1VApx9khL5pl9c/Ho3uw5wLBdDuyHqrUjX41jZPnjfOhmDEomPl
+V48jgKLwbsseIeUeFDo09dXiPVHBw91M6/4Azm/3NcikJCOiETDhXlH3km8qE92rb/saxdGg5ox8oS1b
+mzJX64FY76Xf8mHHQr3mkF/WH7r8V5olWE/6GytZTDMwuwMjYPypUtPEHKsiPpwJmIp738nUy0stxGRI
+hmy4dl6NF0IWQq5E5vhfrWxnXRMnJNxCfQAVgnZhi/QDyoRPhdD4qdTShXVh3XzJSJQCY+YEDEXY2kWV
+0mnZ2lGcEDmcGzruZpy+4hy/42ilkX2BjEqMSCxpy7s6tijrONVUlCXEnV7kep1N4tuFGkuThxQrRhM2
+eJTNJ9QUzL4+kx2kpA+4LrlsDPfVyUHPwFNN1AFQlTmY4iHYfE0girpTSln/G7qSKCBnNX36Ozjh35Fj
+obxTPzr2An6rbuCVozwzrhLnWXT015PR0bla09Pp9HR270gt8VhMTjMKXmRiXCm4h1vtmiG9jM+TbZFQ
+Zlvqy4SNLS+yO/wubt8lH86TLDhKmzA/pTGI6ER2YwNGX+Pf6IgiZ8SwN4Nuu6vpvG7THu61bxStqlUf
+wrA2cmBARIS360INcpBlMZCNu6eMERN7RqKXEh0UGk8UuNm+yLqQ6SjFInNM/fUtkb7N71nlKly0NRvX
+pEW4THbF2WT0aKLRb9hw5Sma4FmHTcUNGhFW3BYlvnprm5JlprzT4jQDPncQdkTNuqOKFp4c0TzEUSd2
+vugBNpvXuu2jU6/yDfAbnaV0oKp0mmpJ8Cxib6jJjz98DXEF7S5MlPU9Ohs7vlTBTqR7In2BzAfX2q+S
+qgI4BnsPct7pVIwXvK/G7BnI9CeWncGy0X6FXq7iNNPO0BKoE/FWk7gEVe7bOSO2UaIoutMKRlZfIAnO
+M6ArQGs6c2TmqXbAdeioys+p0+n439XM8Hlbdaopva9s3RxiD6fvDXEBazsrJ9A8pyMZufW9qdffh2SD
+c3IP/r9mnkrFJXetKgWFRp2gZ9WsABvAY1w3OEWDq8AWk3J2FVR3OEddVw+wjAeMynigoRoPDEbjAeDi
+D2inom3iQPwQHAiG/AHDkx4szlbywADJQEuUp81afkHKHFhM5QMDo3xQQy4f1DDLB4I6eaBNvGsMYM7X
+YAHjgbJPiiKnHbj2Odt0KrGvMVvNuuHQ9y5ARhOD+VcZQ6vMII9rALhqX9HiAg28A6SubjFoirrRUICB
+SddZE7GtAT9TYxAK4j1f3myyvSStBIBSNBj5tRODP4Y6ZLwJzBAq45ZUDcCRdg9OnOLhx1F3HgIqQJEh
+jB2Myyl6makPmXqfRQad/Qwn6ORIXdPvX4ZA0ruiJ386OZwFb6Lpr4ez+0fqNZ+Xw/sTOu0PTqsZ7u+x
+RQIhoZjcOzq/VM/0kRqf0ZTbEiuJf4Oyygucv8P+gMeuFPyIFZ/IW+IQgbwYUqEvdPK/f/F6+9UXT57j
+8v8dwk6PTo+O1Bf8eXp6RRnN+iF7LqYPqMbR5C+h+DIOfUBFbOl/R+oVWkgzBstHPclAybzlv8+zyLt/
+5BnHPNBWZgPDDwzbXSRLLYX/hrqdwvZM62OgTGQcMyJqi6Lot91lFn2RicDuQ9bUiGQ/pPXW9pVLkTqb
+V9aBQirbe6WqiKtqzpsRHTdVsxDXReps3NY7ygKNGlIQScYqbLTfQgpPFBkI8sJe77MKtxK3BwlTb9NZ
+MNxk4vAxg6St9U2jszn2Ib+1ryzQ9XEEA9a3Wd0Rpb+S7xvLYuOKojcyJG7CNxjTBquhk8yjlbjatDeF
+7a6bb7cxSM75bBJPev4mmtPiCImDM6pepW3VPFAl/gCdP1Abq7/nRoZOFFE1+ZS6Z3Z4WPLccSyN2t50
+WmfycLmKq+/EQpn91dljIwugMKG9gPg0OrNJEha4mYLv6QBeqTK2FDcMlOOLgboKvJXSF+aY7nti9k7E
+ycPDV5mR+b3KuhFgx66Anr+t2AZF334d1FToStyEphZdijZy6y4ssaEjvnJqzcuJBTANobvQ4QrUjyPv
+5XevXuNKzdHL7lDlix01PkjNRK0vzHYQs2WJ71KNeb2XUrZsmJlOIDZkxA2rmsZ0X5lAb5mlZIyamYry
+Fe0il2soMMLQtnk46Gv0AhrbU3bfQqRbycqxtKcnMwa0ZkU3y1vzbCEWBMIzeWZmG4/PdDn69QucxybW
+hjUgzBvsYGZ/eCR3HUOt+3vx4h7SkMVl9W2+SJdpsoBnraSKz9l1uzO5Qx4EOlkchcq0/Cafx6vwmSbG
+LrPp8SywypRAU81Rc9aKpVetbmmRV2UUMTHTOfuROLoeXF1dDYB8P6DihCxejNmpF3jFH19/OfjMU6Lf
+iuv5+174nKoEkBK2PDpag7j1xEBfQmSSXOO9UdLlSh1whGt8f1uyYpcTASE6BmB4tMqogxB3gzyR+kiK
+45KOJCdOfQT7cZkUXzJEjiTxTODP337j6bq7k8dUxoT949V3L6RcIokgKUC7uWJe+IoXveKWsigZeqv6
+FblAc4EnNzLR4WivCaYq7JSzbckomxFiSNydnQeb9S3k0YR2Rt4cm/sh8NxDCm+GMjYfAl7SkSqSSjot
+n2QBB74u4qyEXAaBb3VgSzK2D7nMJ2iixOEGdKJo892zK2dzDFuXzRqGjxn84K4NHiqxFWpRv1KuS8fM
+ZanZKMixltZLkrqgPJ8nSyKg4QqJDdOfxasVFAdKeHafw/ndZV5Ao+ySMi+ruNqUzzRqofqAc/M9/pzR
+kX8VeeK7hZhy9SyiHo4XH2jHqLBMiZL6Qc+JrxiSa1/9FETBCR3BZ+JVeB7czGE9qtHEoteaeomDYD6t
+9mEaomp6MttV0XzasuWc7RpseyVse7VDnZ6sVs1qlR3SD67UJNaYnCVaQp1ZVnsNcWXNjSqYg+gM3tOi
+95BAvmd1hkR9wG1IZXZbcA5Fuki+TS/FZ0zHpohM1sNLHSNKTNp6cLr7lsFvTx6fscq81n68xMY7vRQf
+jtVsJizjs2G8uoo/lETgPNNj3sL+VkRIF50Ap9vtlYmJa/AhR4Tt0DsfnpWkriweuIDo6DKl/nlWH1XR
+Oax/FRUrB0f0jI9GCmAuj16XcQqFelrrke8ncFZMj9vthwzXADXLfp0p9/VdpniH73tHRzSZ12KpncF/
+5EW+gBRfLCXWNkSiUExLYhn5fR0k9oS3E7oeHXYy62hhFjmdJvkl7fGsbWOoca5/iyBXjehRj21JcKFO
+M5GbcXiIC3V5O4GiQTF9AGUaIiuqdSiU8/Fs4n028kLv4cMHHiv04JBrRePcGvG4dDG3Xg+dQ7BG4bRk
+momneyQyckZ5RSc7gp1A/YatUq2J7HsWKFnpeqY8G6+oh+TsVavDwxFflsgZD98MbdR1hxixYyk/TbVg
++nYRl8acqPdCn/USFRAS3PmNWLy4dLP0937kn2W1ZdUhddXEC/q6ldpGRN545GAUY7DuZaJeZR23MVc0
+Q+8dv4m8/vus38e9TGcxno2BzNOlIXb4XsGlftiA5dmwvT/53tfLgYkzeJXSDk0kRTsl01ZEOt2VyQta
+iINvMbu9OjbVyq/nS92PeHNIpZ44CcjcsKC7JJ3BAFE81cglUF0JnjBJ5blLlVHZ1tqYqJw2v8wmt37p
+g23qYbm6wRNPHXj951nfGx/8Fo2GI75pDMI6GzBdQc0zUUfIaRJ01DdV9rMgbC2pA8UaHPQxT5r6VWwq
+luqZWgfa04IsHbt29BYbjK+AoEmPnq3Ijd5Fw2PF2yf9mo02PN4Fz6h8f20qsYl+A9miF2hw82xYn+DR
+MZblorUA2ap9SjWbYWoyhYxehwpTvqnABMEMNqleS4Ar7je19nRkRns2KQMRepxRqRvqP6L3P6h3xin8
+CyEP+CQL2ODp4MX4nT84Vi+IR+Dzi9+8F/mBpcg8R4/pXZP5n6sP6r26Uq/Viygbn9Dgn1G9z6ITaBey
+qqWpPruDr1QMjRaPSBy3f5LHo8nDEOg/yePoZERNfzAaPaYD4sHoIfQEgGLsX0XfZv6ahhK6iVfRd3i5
+oldi7ecTv7W4X9NZt0c1+d43tG7tcqbd73XXPhC9pg/d6bFsbTK9hik6bchSUWoaJFw4G2SbnLyIvCw3
+CjGhbo+EVpemIqH/IrpiWoFIGnrirfE9PfC8o27pvYdo5n30QuHQ7r3AjQzlkQivSF3FgMkjHD+G5ogS
++wjeIoK4+wVOeeqtC/Cs+eq9GAAt1fSDeqGezYIQH0Bhm/BnFP5+VmcK+si/BDlbNaf0fNJgWEOXlcUc
+p0I/hMjpHMZiiZM/hcGnbmt11EyxXiGDgTnSav9TrQONOGrHofwzJlHB/dwORi7qrhDeeswzBZzmFTN8
+txklOGmMRemuvredskat8uCDbv8WHaRrUyMGzou67N7EWCTKt1v4+BGPQapDKJLVQpFc5CGFMttWumsC
+SXzbpZzJYh/D2bJWpt27NfNAuzNc2UNERvsJi9Rw+GvH85TAENM4oDrWTONEClxYKGz2K9DVq2lJJw7+
+1sbrGyvAK41vCGyzVA1KVQQx14gp7hubmQjEehsNf1QzztOS1TbwYRbcxFFpcmTrmjLYxVAfT83ciSd+
+3JM2gwo3FYEKM2AGXB3M725HAQBrN3c7VEvKBAwMApPa7ZRT12AzjdvcWaMt8WycR3MzFMZ6Gf1Yy6JE
+7CBWudm0I5zZJgAfsI9dVPFLZslx1Ru5AewnzDSBkQJy5RTPYAU8Q/Igj1b2ck0oAcofeCK5WB1Th654
+HHIang0d+/yoenFgz/4N8iuj1L2DKoV0z1lYaLJggsPmwm8BRhYa5hNES2fhRkSvvZEYMkDTbV7PKgyA
+M7NijoniiRZLpsYaeBZUEeMTccNwsMq7Pk/X9oaad+/QY+FKoTdmIRviCXELdJ7KEJYMqU68G1UbTaly
+9MJut2vmUxrRHy/qCmg5jvzCysFkC9ICr1papQ4aAq1bwoEv3RV+Pai/NOReurROgOqWnIoFTjqLbitf
+B30tYc/nO7PDWfmQb+21m248DZMgrrXlDV4ZkxafqCHFRGaZ6BIjuQiQoiyN010UryknzxogKGYqlHVR
+8aAh1/b06cdmVBvAv3KXvX8BZSEKMqEHcVuSDCX0mUhCWbXNSEVb39h5U4EtB3xZNsz5hjnCAxNbPLW0
+B9Cm3AWXp73MIcm22yOkTRZbQ/IeabV7JxIb9txdDN+aNrFt70CphQiPr1lpL/aJDlR2GUDRpthDrWx6
+8GhJVaiHUZjUjrpc/Anqu9Iv2YPXU1yHRsHpxJ9Eh9t7wfZ0cjo5GjeWGggDWr9zLdkTGe3aCPr2sUG/
+zAShn8kUQXIgLlSYUFcKBMkVhrdz2qMMFuOunfnYYSaUDTlST/PLTzMzSDQBiAumv2yj1tLx0gxnz28w
+lIJvaG+A/lA4D92hukDm3w8PPfw6d0eUqTSDgVwbbCGwKYaNzmwq1bQ+ws9wIwAKN80QtZoIagT9sYKC
+pywooC3WRje9lbXEE6bXjOxAR2c9nRxryzmD9VIVUbtD1NUqyTz+2P99qIxexeUBUfwHmEbMeaALdqrZ
+JZFQobhMhWED/rg5l/WlFvw3aimjE4ETxCrTx36rc4tWZ31pIU3Ymq3R9xjK2BeoCCo2qnYOgAqdRhoZ
+IFM/ZOon+Bq9B6ntE6bPfxYNI+fSTC8NZqwTnPIvs+AloNf0qqxJ1K/ZGzkdskbonFwRbfTzt998VVVr
+LQjQJ28FUzqb8PfOhI0K+d63KbbyfFlxjq9fv/QCN7PWne71RdFu02RvnHvadTpfhx0eov7bLSqzC79G
+70T7mfoNa8S8KKNe7wfcjl4RU/SsSBY0wGm8KmGX94POw4LqUF4cXf2QmUvS+ghzFT1BB2fu0dVAwaVS
+9W129wHlOlXlbYbrLe5KNrQHQz9sshrm6wTrlEW9mT50+OBSdTTe/cvyKi8WQXhHEiwwKkUI1Jo/cAPB
+IEROAL2OM8t+EKk5bN8AdIX5dRJe1G4XpVPv54GeZ8liwLCBbIHfFR55zYnpwFlz1dNgtS+/KlUK2B49
+7zY071YipMka4j/ZGMS6J2h48jVHQCn3WlwkjQv7dNpuHzIysHtE41skNnCrrhMaykL5mhYwsfMijKWl
+GcPwJ3jYa2aG3jTiMma81mJ8v9LSAWJ5VsPOeyE/2PeHuGpcnLPgjjE5m+HSp3NbBsJ05y1xx+V5u5Jp
+F70GWUpaD+nk+OTkAfVJydK0k9HDICwjKWhCBEb4cPRwp7Nb0KLZbnNIv4iaWB8e5jRY1MNqA6BwmaST
+dv9OHAFdATu1qN//KePe9F+yzRJrJrH+uyj8+fdgCcp9DKzmzjGhnAq/i6KB7USblPk5Uz9m6l9ayUrA
+ArYw49/CeB/KVr9kDuzJJNTIJ9vAQKUISkoNp/IPyovm7CZhhW3K4O9ELWXFTH1PjcHN+7Tr1k40hAUm
+7CpJtPUSXH/ON9j00uiXzNg8EgeZHh6mcp/iWm0nM2jPis12HLU+iQlkj1nBPiQkJkNtyjSECjUcS9Kc
+PKZt64RRIWjmx1SQsKEsUsErVSdl7SMasWK7PR4v8gPGy/OGn9AJfBSVytjumnxVTGSAwVSj7GhC6bYd
+oTIAR6RpNhisrAwqZZY1w9QtqqhP6xlljbDxZWlFjHQGqJ0oxS1S3PdTbSCfTk9mYR9/Yec1c87Hf7oU
+Rqdo+OeMD+ufrRZgfUb+uw1mGfnfQ3dEFL200hyFsDieRgj2oWkDTSVmM9G+7CkpkRkiX8+g9WJF6g4G
+jPYZ3NCcQq5/N2gcbKJe36F30DR6T1rxGOwgfnA+wiDSuII9Hssd6s+06tBNxEs49rQbGQN0WH8zXGwK
+JmwHMkGP6hCMTh4dDwpGk4Hpb4W5XDa6YaWRU8xHWsowPvZrNWgo0lfp8gPLORM13Sgaa+rU48c0dYlK
+Df2yIYJFlJmoqNEmWtq73Ru0OkygTrMuQxe4GWsIEgBXW+xG48R9EZcwGCGSBmoOcGedZvFKm7inSRlW
+NtBof2TK9k9oe9B0ChHO5lFJi0NaOM5SD5uKjmJkcj0028AG3jvYZaF+HDYqylNQf0g4xPaj7X6mVAFt
+j5v63FFHafi7ryat8Qp5B2h6UWdZE7Fe4+JxNs7cYcxkGI8dUPL9YVLVjM4QV05uQvXtPDAyN2zYIWVV
+hT/vbHfQXE9/z+yC2tBmY9LU62psRScwk5irf1N3tjTuTDEYywCiy/rVeODjJMtrvi0qfDt9VsrMtThL
+L8ON4gMg1Dnwyy6Aqbzx92bKMu9aC0+HstqBfjYShIC1D0wMPNsFr8PkzdmyqmIP6UnFNSR6Ij3XAklJ
+IwZzF5eMjkVELjbSgCHRH6OcORz4Oi7Yq0NBQeZOOmHsgdjF0KBccZMvbD3o85hVDTWfD5RJm7aY1dXM
+A6kt9FekVKoX0DKiNJA7N1Q4SokDeUKdzwutRmlPCnXDE3RPW6aNlAfJrUoi2cBDVs20UtSxi2zdxMiq
+Xa+wOQedAFQz/ssHJD9ZsWkljqw6XHkS/zX5e2YjJkH4d+tYsnEPkRW3gCrjghCaqNBcYgBdMTldNmBy
+gCsGLF0Lr6+85TXIHQ/wfTxRqZ9xrrypaRiJBcEa65NAOZg/4qqwfuEdX/uJ4Wsq5b40WHGbZrtdAR9J
+1SH9Pk38/YOsK6xONBjQROVHU1U9RNutWweGYgrEF6rbKb4xzYalCtEwYp/NZisioiMeaLnKr6Lp2j6r
++vFn5/mXmdJWpt0wbY6BpP0oWElBA1pA8ngKQ9UXSbIov4k/EKlCqXXm4C0AV2MtziZrbf4brmtAtYa9
+K3v6MfVkhsG2y2Lw1RUoL4jfePdTEa+5EiXOmP0hcPKo88ZNgdM77pdj98sv7peT2S5w3BgwmrXeiv6l
+6dRU4Ff1JqF3qRzY+Uy1o09x3Uek5XKCFiWQ3vHUDiCoS7NNMp5jq6B5u2AV/Rpfpgh2gpucll9gvmih
+x5yKXExM91C9MOWX0UIbnQZh10pSNzt2+OKbaFFvGailmPFqhLBNS+samJZJDRim9r+LLh2VJkLf5+3V
+azXr5kHdrErNAUOxc3p2HsQR0bLLCbogpHMfZxp/odXoI5C2ZD7z1JK9XoDGNiH614HzgO25Xj14AzBK
+wx9GUbgoK458qShw+FU5a06lRNA78Xaa+omKQrnxohsaSOKEN0DVD+kj0u1ZEsm9sAC20aEcaVs80BRR
+pjSQG0gIVqYorxh3QNS9hZ6LtGczaau27LzSLBpzY5IJdUwhj8KQtLixrObGdorS7Uu7pW1rOR5tJa0d
+MNtOnyeVOCPQ3iDdNBZDvP6+U0SE7StHApTx7sIkJKfGR25fWNoVsMaa4Kx7cGb8ALZj30/USB13fwtC
+4zyQOtU3XTmouzy4X/Xrt2YmZZWstUcyN6iGfxV20+SvNFAtZELE8k347509ab9rglTtTVRnPrrflJtf
+dGP9mrbs/c1KbigGJ1zraSJDgtt3vBsDesGsdsNMTBAt+hzRbDaHQ/1U4bRgKCVGnatoXYbNYtpwFWwO
+cc3dafPfCwFl0qyd31E9B1hNp2vip+nAoIaFbFQf59pVP+Fl1a41n9xXOzswevzELPQ1LfGOYGBPRTft
+1uoauxiCHNBwXNNVOJC0HdwHPnyUHDVKDp62nYk26BZrk3GHIWhjd5QpQXtTB0LlLQZJYtsYM/2b+KkW
+cynJeM9KdBlTk/M/MMHUt2lZpuF/DP6kGplTTJCObakWzrLaWdNN/e02pw9R+8BlzdhrcHqJ+GEqwETs
+AVBDsQId4PL0SBqMIca1fpEYGWBJa7a8YKgTWt3E/vp8a2KUVYbyPYoV5JdCRbKyowOdHev+FRJTR1Jx
+0GanG37tOxTUufix4XL4TUHyubvNUC9hdW3YgBkrDXE7jxs4p0aYK0T1qunMHE2dsN29kb4iptVK+fS9
+msT3uNuZqy1dh1vYCPniAoivM8gCiZtBvQ8PUx8vjqU3s2nxfsR/ZDXmnEnE8a1foHE2GIwDMHa82nri
+gVfk91xX/sS17fHK8DkAk0tGtAC2FIC/mGeb43obvlT5Yr5gmN9FIl2lXfgCHlwGvku9wnSxn0S6a+/q
+V5U1+wtCsanpXQ+y0vpVOnvW7O1iUtQSF+4ZMynZ3tOtOA8xhL9mEPjXAT9nN5R1x1bSsZV0rHZtjP6s
+ZnaqC6Bz5fYnuyw0fVlxXzJ9GY3G8eOK3dkWlAKmAJROKtt4qWsUmPluGtXSq0ubQoroRqjJMNlZv8CV
+AO6NHz5Ox2k/OhlUxG3/myEEiqlBxesDKHRaw9vRa43kgWVksaIKDfCYOOhcN+WK9u3n+VUWplCcYZpa
+ceCPaw7ifV0HvRZMYQTr7Z/oe9pTv85qTF/JY8fh320q5wPnJB90RvU3nd3uj71t7W/YZh+2hvMCn4Wt
+NOp0yISR3zcKmzQ3VYAJa7VtgGbDJZxgkDqyE9pManEn7VQiQZWolYbPcOA+KNzuv4UlCyOmNfLlcjIK
+a8Rag5Bb06H1Y1g/YusRWgXNLSfO87SONQud8NoBj0HKNfKPoj4FRoy+q99lI6CJtCLC3wrmVP0YNRCt
+XIVUpAkA3UW/ztpQOuu9HUqHg5wXWoP5lRuw83Gn3wdaLszJdHwbfjJgWf48JzLxPj++/Do4OmEqRjYh
+qPOga6I9YteIO+fvoj2H11m9iRn/kfXNifUGoeVjCe2CxLEnuOLHk2zkmdlkisGAthmbCFOMKU8oAym+
+lnEEr42zlXJE90lFjMiMcbquhYHwA502Be7F+3gVHT9Q9We3XT/i0vHHDIYDX+vIvu2AZiaByRV1dPNg
+vX2b+scsUD/KqWvi8+wjcnSVX4Wfjka0EZRVCH0tyzKwawNLevMV6J/E99J7wCLqVhqEEyE7Zl2owOyo
+Ui6L9EgY2lGAwKKWft+e72c3I8et374HEp0h+C0BD3NdhY+t95/oBhTWSAFYLRwBKkF7YYFmDZEZTeBg
+8Qhq8Z7LtkKhct3CEwFH/JPsLzE46KfAq6Y19GyVUtwfaFvUbqEhpe787rOvM6gsBIormg/pb9+Ha+nz
+5BdpGM9yw54EA+iNcQb0BoRaaZrg4JqUP7dTgoNxkuIVaXdBmGNy6MG5sZ3Z7YHPQYHVaHJeMPZwd57O
+WXzDDI+gtZkYkVckqxhqPFoMnopXxzxKdbG+lfOzL1sYEhjwbAQwJB77SKsdgGjB0TK9Thb8wgvWuKoS
+tlVNY1XOgseDY6MMDrm2Wo5XE39OhdeAeGodzdHvcHUtoMShv26iZ+NmcukGlQH3/d7JBGLDXPYAmkmE
+3j2GFCF6y9/gV94GMtjrOgrK5jiMaSzvAz2yy0B5QDU6Z8HypBryS32vFKbcYRthLh1mzfpPaV7dNjwd
+8SC3l0phlorhLmx3W9cW9TSYVHSk3TLHQ1+jo8l4v9SOc1TlhrJiVu1jkoFR2bKesSEtkqDPYmj0m5kh
+HFOw+WhFWHhhROOO64iH6V9H1EtPxoTzHtgGCnHIDmJ6ZqnpcZHs21HZaYxWEVFue/8raLq93tpurWco
+podcL9bSS2zjahZixzplV1FullZKSHnvXCuam3rLgEa93U08ZXchCdf7UxMK0e4VR78YpeVgvEd+pn/s
+aKTWgsMG6WDF8tEQT4RdnIAfdJDU2zs2LBES/PEpqt1G/WJyTava2RxpluaqmOShE/4axEPAyaOc0adQ
+q/aRpVHvG3DjRcujlgAV45YwCT9v3B5Nase8aXIFyZ2IjyRF2Du2HIb2sWFhgAX+WkvSd3uQoZLIwley
+i0ivnxh7ghA25qHHPkwp2EnPoiQesNQdsHo02h0ACtcRNlnhQxoI+ryfas8DudiHGI4rNJjVwfjuueCq
+Xo/3ezWDcvKt4+/JiUdtnHHXZ3XXsw50+4S3aisZYzWzrjHNBaRXeePNRJBFZSI03+rCaSKlkXiyYXUp
+alMJNAut5cTXDhC/E1kxScNKxXZeGQoK8HXRLbuIprUkYpwtXiWrpeB00QR4CoXjPUbtMl9sIH81v7dE
+AH2YF1U5ab5G17SrD99+D2QOms73omvlmbrVWdDUTuGPW36H8eXCPPueoHpA6NSBKX29A7Slf8UjHIw/
++ujoCAB+VV4k1OCEnn/bpEXyttRFHAj0Aex50urj8oDYpAPW6TtIaSJWrAEuMRfICn12QP/PEky3uPiA
+hNBYBGl3kFMJxcEqPSviIk1KCyQWw+HYR5ShbxR4nnOWwYHUc6jrEh00v1P1vyVe6GWRA6CtGL6hGG/g
+7zfPlsS8QKGzGeEeRbjnRqiKDRw9NmPxpRdCdenuR8rAfZUOlB4fXuTVu+RDOXxbIhBwCmV4dHSeVheb
+M7CiR28TYCWeHzXjH52t8rOjS2I2kuJoP6uP6lNsEdzYrfDCP2/gZEV2bpyL1QIv64voXLuzLNTbSL60
+oCkcZQobt14NGniFNw6YQQ+JMztne50jiz4rgIxHqZxKJkp9Ba69qKOK9iODLQQGF80z6I2elMFS/4Xt
+Ba1f9E96np4N6bieXxDDGglY0BBWZzAVYite/bmFvwEHPB7RmTe78dkwXlWUEy5hVgyRORe/CnjtUyfQ
+UFXFSmLgyY2Cd4lDyyLmOH7PTYBgThDoFAiQFKw/IrH40c2XAxBtPllO4/58Fn3UG4U+nsWeBk9OfyD6
+i80lrG34s8lAeylaTm+PG1AhWiaQRG+NOHL+KBnPRWVrOX07nc9mhlm76L7XgKKuLSS60RaAoTcafkbk
+TD1e4c1nITsdYh/Hnvo8hHdiTx0/ACAUU7/q+NNQd4k6/lsona6OKR0GSB1/DqJoU1LikxEsltYlq02o
+E4qblHNPPTih9JL9gwdCQW2IrnzwUJ4BD0pvn1BsYBM8oNIu8ktEpgyYCVIPqDBOQmUVQgU8pLIk5cNP
+cMrDRIueKfEioep9Tg8j+qEsjumH0p/QDyV/QDUeUdqH+D0OvU/wSxX8FL9Uu7/hl2r2GX4p58/xS5nd
+xy/l1vfUR8cjymhAAceU0RC/lNERfimj5TEeKKflCR4oqyXKPKa8ligUvblEqejLJYpFVy5RLrpyiYLR
+kUuUfHKMDEd44qyR9wnyPkbmDx+ywE+6+xj9oE9pGhSp0ckJReFJD69weqaFN95/iAj5fyha6PUoUuj9
+D/Vt6P2FOjD07nmKcvqrp6iiv3qKannoqc+4B6h+vqc8AM/Ad+WAft/Qb+Rxv3hj+g0P6OFjL/zY+1jR
+U+g9or9D+n1Mv0ds46S801N62Hq73XhhrgM1Tix9ZIBY+ZU9p3FOLprOiflSfRbd0GkfXjDN4DdPFH2G
+Uk4VVGOdzZ/2fliUDc/z/HyV8AGwPpKXAT4MTJoje9K8/OHNq6+++/Gb529+fPXFm2ffvXj99Ysfn7z+
++rsXEU6qcR3t9ZOnb376+vnrr6LPnFDxYkXkzOIr4l0i+8E8cYkfXtK2WX2XJR2h0fs8XRyMTJZv1sWb
+tPz6i0/bMsYPURa/T89johsOD+0jW8c8ORdTj/1AjYJ0dHr27auvvzjwp5/+7bNZcDo8CsYfog+T/ofp
+8SxcxqsyubN8vTF9sKJqevrIZfXsGfkBx5dByLKmc98o7zC+XI8dJKhvKWhVuSEvKOQcIbXGzFf+mVqC
+Xi6vUrTjrPYWfDOnY+bgOBTgUBxy5zj5WoBfSxGCYsbmIrJZUdzYuHIoVRatrD/HpTZ3SsXlo7B+RNK9
+jbLxYPD2Me3hQTF9O4tW9GdcDMuGqdRv6tK2/DfGvX50yT+TwXEoAQB6kqARcF/Gq6jYoaS3lPPbR9m4
+339LWdjssRwEhcQ0gxZiwRm0ztqPI1qahUBs/y873Yb8TCG/bXKEKVrxUGDSpT+m0/Ij1Bd96HhqXlFD
+VsOM6I1X6dkK+shf+SseN/SpG3W77bFtyVmxpXjvtunleXDvyEI12NGibS5XXB5b1Y95rB+E/PMw1NE+
+6OnAntasAb4DqICpaN+WcJkHuITady1RA9i2zLErX0DS8NexnnM2wXGgJ5135oU6zWdSNa+yIZ/rkMyG
+HI900Ps66FgHLeugEx1U1EEPdNDGk6Z71/Ybi+++ziqqXrk5E4LUPwnoYCfar9GS40DnMjK5HJuHE/Pw
+wDw8NA+fmIdPzcPf7i77OFAffYYLThHbd/XpsasMl5rxePTgRI8APR9/OqEhucZpRD9e0Icw85Uu4VPQ
+UV0U6FyAMCIZT2o/ngbmYWoeZl4w5xj9udnH5nWFclQIa34RNdql5nqDGBwbUDljA3VK/22mo8HnTwZf
+xoPl7ObhbouwazfsRMIo6MGM/vxtdjNSdRi9HtvX09Py9PTVbDvYTn8dIGRGZ+a5x/TjVNTARWxBm9K7
+aAFDX2oWHehJ9I7dsF1EC7ObJY8uaD/R+hbnFDmZmRl9bubx6elTM7qnp2f14/P6cVE/vqofy/rxp/rx
+ih5laZ7TRmH0THfn0RIBbGrIAIj9k0cXRJIPQDtTvfrHM5gkL308n0CLoh+diDZ7GZ2PY8lyeg4J+Jg5
+o/LRp59st+ePiYKiNWnePh8BNkEiW8nHp5+o82D74ETLQtLML9XnI4RQZuWjz/+m8+lI+vnfKOnh4MED
+Ny2KRNiM5nLcOgHeqyt7ArynwRlcMZQDVH0H7+kP7fgLjON5NH0Rv1D0b6aZghENV2xGjkftIooxYsBn
+pEweRedi0YWf2hoJb+oC8COiebLQvR9dAIdmSjN/Nn6HiyHZVn+l/VSeNYMRq3ng1GDRqgHPGZ045XoI
+6A5KfMyvNxdcLX6pyxngnHDSAR4FvSVfZ7WMLNY+Htwjf4VlaGY6L8V8U8yT1soDtPvplP5Of6Xl9skz
+/Hk+a6yi4D6tn60sUVmLtCidJeqE1cvx81lfHn/dXOONXvzT08k07EWz7ZRfgtPTX+mZy32qCzfB/UAv
+WOJ+TW/SSp2Cdcbq5HHeW5bUpedYyD7td/2LGviGdioKPq8PLPCKFLV/3th3ie89f0RD/m56PosGxzsZ
+0GMq6p07oJR2AG3/d1RkgD9Rv5/x6F9EiVuz/VrdoFr0/m56gR0HPghY/BQgYoTB8HatWn/0p6steWBX
+Rva7/Qoh01/1XkGzjJ57dt+QxB5v/8P0nIjxBCTR4WEROCur0a6526PcSnM5H50cHuoTQzLOsW+Zhs0N
+ucCfzi29dASj1yeDf8+OzmumpsSOVrYICs18T71+1yFW8lZT0s7EXkZ21vZz4awSvS7ESI3pdqJO5fc3
+CrskwkwP+W+PLqnZvxl9t7PpbzykldNLwVthcUwLuSUzI1SqzMqzDdWHXX3SXdtXXkFYNNQLqfoI3tRA
+yXL2uoKGPkN91tgGf9Myka5KuxUWsJ3tlpgZoixS6H1owEGGhaOO6VfBeK23F0zG/sqvAviJs32o9421
+7sot0bZvJ955SnTGubv7/AKaETVcUuXavhccviAXeJlVBDVCjIU1b80eFdSELNBnbWrmWmapx1MiFqEf
+n1MnBONc75PO2ljBxS/R1GeD5V/PuIuW/YiIbDAgq0FEdFBu+IHWf56TC8PoUy5Z/9ilor1TokzROgkz
+9Fq/v2Srj7x28dlVs9rLaV7Pyrr7viZ+7aOlXHdBrhXcnEU3Mo3CpZqHZ7txSj2ss9anUK7Ohgs3l6fg
++ozqKLyLjRuspmXNzoyp8ZJGE5vsb4h9SeOBC1w9HpePKhqPS8luHRXTy5maR+vpA55d88A9anSSwWDB
+vF46tbT/IphF6/Ga0h1jB6Ept2ZS5DdgLXx3lRmvz2wl81Y6bh2Mf5vOZ6LvstOBR9PT0eB0s6T/ZsSE
+59F3/ltIOAxzurQGddbIzS/qRr+FshW1c/pW/T6zTW0ckTm79lBr0RMYqQVfvmuR44L6Ym4uvyqqHZ1O
+62mM80nEEIo3ei3WfofNUAu7gwu9jBmvQU6vdBrXW+ls/BE20+AmiWJdlXMmTd4R8TKa7SzAHo7Bi0cr
+qskFNuUl7fpMFjaTBTeSzmwb0HR9F/3OtkmUxTu7aX9CR8Iqzs6ZnHzXWACf4JDp4ZrZKPQJAJxtE6gc
+adW76OXuAqDDQFN4FxDRejm+7EexIxy4QGzkIKQsfTMQRxeBeh+V/QsncgKb+5v3NoMBAsz3Mno/MJF3
+7xqV/iQYf+2/7Z/DvMhpCew21G/6W79UF+p7/52icm3Y+0aK9xJfTuXfZOohV2pZMVxEv1ks9nrVXddb
+33TGzsqEe6cM16vk+03OztELRuXXHPj0iWJ2/vRj/E+osY+F6poSEUaczOnHzN74kwifaFTv2+iMkuXh
+f5LQayb0nISeScjROaEu7ZQytYl05pKx+e61vyN9cKQ+4g3c+/jU8wz5fCYHzDd0wOiGTvYa+n9U7Pb0
+P+b7f9rf/yPVsrX6Dyxvby+eHStRFsPu0lufPTfzU+9jNPls+D4pzuIqvdSNhQZ1Xdz/2HGZ8TDVGUk+
+My3cof3v4ll+KVcUHDJnd6427MY04hnl+heghkxCoR+3ySpdbtH12yRb4BHn+TZd0udtms1Xm0VCb6A2
+IS5KtusiPr+Mt0x/bq/iIoOs6fRsa/1MmSb+BQ10W/NIykUThvS/o+D+9vRoAn9Q09OrwazPrqLkMegH
+E3k6HV5smSQ6vbofPNZTZqYXVaNVpgLN8rs6I3WSwTlVM6VTax3hvsyR+xPU8P7pkdP/O8hXz5Prb9Iq
+KWgTq8dP9kP+6CkL2OL1/933/CNeUkf3Z9z4X49qNgbzhX6sFIK/dLBYThyZFPiACdY/IooLM4tO5nfJ
+BwA1lTX1+OtpSbxV2b9HdLLH13NG5cJU+wflgst4/TOH9Cz7SLYFEHsAWUVg+suMw++KSzAjcIBuPa1O
+N6NRPHJnwz8wtzGqb+7N5Od/wOjdP0olRzV9hRiTKUj6Pg8/Ht2YeiIoLvPOrP4h63Z0PdWsZn+LDlvQ
+nzdwQ3b/dHFf5uTifjDZ4ve0j4FJpv3TwWyCOJOAK2FyVd7o+OTBw08+/dtnn3tUxBdUxJCGqDy9Oh3e
++x/aGbzT/9DE+Yszp/Rm/9SHTLbe8e+1BKTPmWh7/ljAb98eHr4lloeI8cyKZct1nEEq+1aIGlBAFI2i
+vI1+G+toHz9CrIP5Ki5LiKXfqo+9xx8HTPe/jj746wZFWajnxCrYgU4mi3AOmL3Dvxx/OhpTUQkdj8yP
+vA5MCa9t9JgORmpe9FzYipQmntBCClcC5wqC6oVihEbgFUi1iXziH6GeRkQp/eJ33PIAfePIn2IeHcyC
+A5p+Hy0o4NftAV4uicM/wjcaNfiVfRcdTXnOweFswowP8fvtO5X/t71r/WobSfbf81c0WgYkLCBk9u49
+a2N8HdsQNsZmbJNM1nY4kvzEz2DzyCL/77d+Vd2SbGBmdufLzjmTYKnV766urq6q7q4mNm+QHeT8g2i9
+AtzIZa1k5Qbk+lvOknbzzaJpeP2v8Tr2b09atySvmBiRk0LU4QmW5cSREeYMhXAn8LJItA4EQjq9myxa
+fnQZnpM7dFxWzfVjTvMeQHsgVu3h+Ogt8WoPzn3zoZ0dpHaPD8fDk+Px0HRxeTf1kEIfiz1MVlWRbI+r
+YfTtg3Dv49RKhCKzsUlt6mOtZ+me/nD0ltcfMqds6ZPTKV7hAFadpo4kdJpYnFhsrpkVs/fNVApZRWd7
+SewzeJwqpjaQNXXDDUkXV0b/OJAVj0wGc9vkeBZdqHhs1o1yMxIpjrPj5rKdRrfLchoB0kYATwM3vzCG
+jO3E5gT6DPCc7yJdSlwIskMJJFKgJIqaWUYxRZLr2UYGpbwjkCUK7Cc90YWHszEvsRzAstRzTdydiGEG
+IYaxbLK/P2QBSd/a12sOmVc82xSGZo5j4ZT6bNzFTl8ZDTs72usAM7iNy4pgz9IY11OYu+68flfpjTHq
+hwUv3nFTz2CY0k/Iij9JJf2dnWel+9ht6+N2yMXesV5k6jk5S4u8+xPvdnQ3t9KRB1aIY+XkWdNvr95E
+BV3F7LF/QHSUnl0GXY/tyoktVchvMtI8j6Ym8NCxukYY6rETy3JviRRFy483x99kAZBv4JRFQOCcEXSO
+dnYmsbQFunFsyU4h39N3p7GmLYi/DOs1SRDcv7smx/0fiXZOU9no+yjGvM7zTKA4cKDaiCJNIgHok8NX
++02SE7bdekg5BPos/Zh1tJmZ3HOIidy1WRzYc3ZDu1UnCkQUdFdtH2Wt7XfbP27/lQa2oVjNoNBujss0
+webbzUWdf9mWJXm1fFyJ0Om2/EPHaLomOuVnhyQuIu5HbkAS+03qKOOleSgHDOhosWdMUnCUBBDv7Oy8
+6ci1Pmx6o6OFxkMB9/7+nL7eOjzqlMfQSKXmK17LoqnwVt906Y61lfYbN0gdOdEAw8gPBIZR1InzsocI
+6pk5CaNwkawem9Xdsbi2o+O3jpedx/br9/dHGXs95l8sd0RVcOJB202GZyiY8+pSBLOJbZ7AmVHqRxwp
+7meP2AoWsWyDdUx8tCAbD9b0uxmK/reViMrRiuHA7VNJNCVV+NQBjiasybkj52XdKK75ieN10ZjVipod
+Mw6ei/EQ38/t8fpx9N33sIUl/h54PH1E30MKV4nwG899AxCbxW+Ph4qR5lerWazXinrWHaRv5dK4++xM
+MyIZw5Fk77HNDXq3gz4ukc38ZA/dewfaMPBh2nTkg2wwfEY0HUMzx7O+TSSU7/gIRjn9TuMcPgrOs26D
+mP6sNXrg+98or4nl1rPW8vvccv+BCXdpuaWsNb+bWu6/6D2m9yWluw0s95NcMkJ00P1n9gWlm59tYnuR
+tZXlB56EXNhZZP2Ajx385MGfeGB70R5++Ejhgf/7cOzLDiL6ZXljEf3SvOnI5T1Gx/JAGD/4Jw88kfiE
+HezSTnHzB/Yl/R/9mvT7ih88v7KLnU8WRAr64SNkF5xvsI/K4vFNb2hN8dLLmeSUQ7xwzOiBgWfx0Xpv
+PP4Ow5dT6pNp0J316MNsuJNbAPDGPmFL9FHYBsXLJ1+/hjClafEx3+GxH6/bDJ0egSwkYYgm2Zi8Ev3M
+Hp+kdyAZgHhardb2ESzfUGSSjxZ7VqS9XtmOW84e7lCsi+zhMb0q2cMTev1MDKxF7y5xstgVgvULOPvs
+7MPpzWcLfAzwwZtC6OOGQyZzuIdwT/0Ff4zog/llLIV8FCbb8yKLoc2vxyT/HG/t7xvxcS+3v986Ia9W
+q1ko5ht5esdhrVab/ij4MGeWWFgaPWlZu+1wt/mVJpDdMJoKLGeP4sr6m+tTVb4et6isQzdg95Yponno
+dtjHv6VpY+h+httmbYApRr9ZmIOqaOxlH+0nI9KmBTWUwQjVmSlz8B9btDXg+YiLwvEtxYp3EEzFNnuU
+Zjgo4Z1PccD/KhxxQnfhRJbqz7D5e4rb3qd9yrA/xE5ltRjMbpdqMexPux2FLfSznpKDMkosASlZdVBA
+LypE3U2ZkZvqJKzivZ/hCBsVyxRHWF+lz6Uz66qGE2yLZ8tEYKWwo0zNb4f32IKOE7HdYEmZzanuVDBv
+P5flGHC/uNhCmYOEY6w16fe11MVbTJT3OJxNFM5YAB64f8K8ryfeXGB0TTBbihOHOqmCwRjZqs73qTcZ
+BhJOQVSHITtQ5d7tsDvtKLH1RZzksnsdDLrBSE3ulh5Ajb1TvG+VLYvMl7cEXD7Fig15kqcA9Jqg0r2N
+vjhk2Z3MkSe3jyCJFzJUfHxN3Q9vl3feWD2gp6+XhADdW82c/4krv4ormNs9qpU+eqP877ALwuf+FoqJ
+q9IkFmXKAZeFKT4mudyv1M04pKmoo0eQJMA2BMveXC3u5gDN92lAlZhi06ZUh2oF2+c430IYqnz0gf9d
+Me5QHEK+4YQqgJb30bJOdxF0+Wyg4q2rio8TolOx9VUuZenfzu7mXFfGUJjxBqL1gHzsQpPIMcPhDez3
+VXJoRc3uEtIQnyqiqvCNjgu8YNJdwdDzbEqwuKWeWzCsFl1v3OWTItToMWWohFtSclZA3QFH7hhJaF7X
+LaPu9npAYMYWnuH/xNffgq+drn+HK8Oo/6k7DPkxwmK/uxREXJAj2i7B8IVtfnU+hf2P5XdFjLDc5HBr
+qtkZMtA7d5M5IE8w1yUQDkX4RZUWMPZMg3DzHLCIok6+K+zFVNMZoRK3joBthsJtlzLXJ45oOPhSO3qO
+uwSqO+r1MWFDVz1406WHE8i6r9+Xzs4rqlQp/lsIQrSYhpMmpaY3ADxq0BhNe2TqD5OG0eiWwcNr/vHw
+5jHiTfyOR82aSjtZa0DtQ67SyFtvKFcrCZS/w7a/OuV+b6D/cHPmv1X/8RDVp1b4hKZTjeuJZki36m6i
+aN3p4u42QrWpPnamu2MYVVl3wiK4Q4egvjRGe5o6LQfdqaDbC13zgEBp13/UI9wCmFPU8F94geBXbxhj
+Ly5TYwgDe7k6UjhxxsnVHtZ2uesLHOK3uYwmvmuLFey1ctwzHBC6s8ee21zXw7Qd8n5vN9tuU/TsX49z
+7dRhm+ORpMIs3QNxg21oF06wLtJ2edWE2b7Essl+a98E64VijpSzJU6Kl4FauRfi/LAW5QcToyRa/ePm
+D7l2iMfJZsLHybzlc91O4iyOW4cJ/8NhlORmwYnkVpSNdHtUNCVcD3MSiYOFTo1ueCVxMiiZdjiFGhrJ
+ES0nix1fj020tpPoFK0tI+FosJxY+qS2a00e+fWoP/XXYhz1n3Rea9FOLM0sWb+NanjLe0uvbUZ8fC5s
+7Yp6qLWbW1+1BDJYuspfoyrLKt5Bep9SPGAZ5TB3si3N9JZTyX9LjPG1Ftl2OJs6iXQmWQIud9+wlR4J
+WXkFkQPLKgtGteirddimouwcxXGcCDGaJJdRSOpwo3tn09ZDSqvDjC4s5ZAANvyliFpRRhF3fzEi59fa
+PQGcX8AOwYBfLPylmBulv3k96mb5jDoavZIj2XS4HhSHElHDmyP+KWj9KWj9R4LWb52dVsC4ABodfsxx
+Li14fMTzO3wmL+Ah83IMde44i/OQG7v+xNk/Bb4/tMD3m7m6je1CicG0+HMY/PGHAeHBa9QSV7T+vi7+
+nXLHcwR9UcTgyvqLAWg5P+nx+zDzv1GA/c3gcF/YupkYtfe4Xfw7APRmA0J/MI3EbwfIiyJo06LBAbFl
+zo/J70SZP4bO4CWYvfm3gHbr/0n1/wu0h9aLc/UrfXazWJPG8huSGAl4smmUIr1q4eBD5mXLBrH9msTh
+jV7ad7vp3ipzZQ+jnTXDA2/1QiZrFnAS2xLj1edl9leNNuRuxORq6t3/vE0bQGW+RRu3dnZ0hONl5lsq
+ZY6FzPRpo3m8ZW5nJ/ERb5aQCjMJs5xov0SQnb+42Q7ismyE8Q/lkLITZAOcIYjPncjxLWxmiK3tZ7yM
+l/USHnyGzkvuGqSKWDi1mvBixdmG3+NkDoN6XrJh3m9o2BtqWUcOcEU32291nCfsNYGlBDYTnP2ISJG9
+so1LyLGv0cl0cMyHCJ1czb0RF5dwonn27vFWsVpofLksEbGYqcur9+XzgrLg9r1b66Q1PYb78GTXcTIf
+s1uHZkdX54DNwX1oXJT5bAjXyIs9UVnr54tLNtRjAON0st/tjr57lADNkVDdtUje+vG4kd4T1dFbjUb6
+4KGHi1DQTzwOnVF23QPWkZbdOmThKCuNxjTQadjO73h6lrSvhNjahFoyMy+7NQrDkcEF2UGk6+zh/pps
+J7FhAMvdiz2oxhxZo+cN1dg0kIgEhRiHNVVr2d5zEOcN9sU4K8pNtiR1zFF0IGjCCoRg7LoVCM/t4Mxz
+4gTjeqVyabOFFreLYlf4BDSjQzQjcP30HHRjwuNmnp0ceLimdXLgJ7s0iHrrVxFR6vw24x0HCQsg8VYL
+D0eOkkFNj2nCKLbE4fDncwMcPPgwpCynE4+t7EgscpjD2UTO8yZznY2roxBgYpSlERasX23P8NIDyA30
+ZrEgmQLGoahyMaFzkrd6ukQNzSGXnR3fjk+SDrPNCGqEdBpki/ffGwJWpgsWTQ2/Ekv2Trq/EouJESz+
+0/QzxmnG42EM/3hnpD5SicvXN05VmjOJFMR3Jwx5l0lGtlMWqc8zTN4J+2+yT+R4bqnUxuSNmA7qCAjZ
+jrkAl4/QZmjGWcXTH+5Zmvg0AV/irrrHS0IP4kYW6aq+trLOUlmZJIDb9HvXMCRlIvwfZP9q+s6VHWDF
+bjBjliH96NIElm80aufvryv5i1Ja9NSx56d8+Yp979m3UL24KFUa6QI+iqVCOV/jyS4tSyHk+bH05XO1
+VkzX8FE+b5Rq+XL6H/ioVAvVYin9Ce7Lcv68kv4XO68qhYZMmekSPOrVq1qhlL5kN1WicpbOu294Q/xZ
+WnTu+CACna6vcGDxzRsxw8Rz3OLb+D80w3RZO3gBaDZ5P4Ov3WzKbjVwLcvWtHWrWo/5t/HKgvHUhz6I
+4eHNcLy+sH7K7MDZwy7U5Ik18tp9YbWBt89xDvv75rBO+PLBHFkF4n13nCBfLIb5cpl+1B9hvkJflS9h
+vk5/hTB/1fhQrZ3/k7sgfJ8vfLy6DJk/p2fjc6lE71op/5Ge1c/1Uvj+qkzuL2GBEueLJbzp8aFU+CjP
+y+p5pREWylV4l6/qVGSpGBaq+XKpXiCvarmcb/D76qISAqPOG3hdXrFvhfo8zzkQM0WOeuRo5N+XOQZ4
+LHZ8KtUoHlUOKWvVOsW9qtVKSCzv6yIH6Y/G+cX6B2V5cRn5EAdXw0e9WguxQes9GlZ8XyiExRJBr1pA
+XoL0eJ/mr8oNepdL7E0ALVIDw+J5/SMeVMlCgx00jqhpxbBYvUIDirXqZVi8urj4gudlWCpTMSQJhaVa
+rfypHFIm+Uvy+blQumzQq1TgB6BT+pmyq+PVCE9LjcKH8PSccqRH+TRfaFC9T+VXOj+rhKe1ElXt50bk
+EACe1qoX4ekVocMphh46/azaqIZntTwB7qxWpe7/kP9E4y78UC0Xqd0fw3NqX+O88SVyUO9F7mvqG3TE
++WlIOHNeKZZ+pmeFgGkCKoQD9VKBXdXwvB7+g3AkJFIRfqSqh+XSaSMsn38s0aNSqlTDcjVfDCuMkPly
+SISDsatSrcT4VKk2wgoagQeVXMUf/+olAhE1qnpZkgf6UogKf/50Vap9YRfjc4OdP1+U6c3AIAgSAaPa
+V68wWqqEZOFliVITdIhuVUKaxAvndUS9rJ1f5CkzeiOwVi3wo1S8IgwRzjEkZCYg1ChXwtMiP7hTKJNq
+5fT8DFFrpVNqVKVQqpPzklLJWKwRglY5GEhUQJrGVQ3+n6oErNr52Qfyo/GEQUuOz4XqVQU+n8+uzovo
+otoVdXg9/4keBMOLfFgndKWM6qU6GiAoDxjQT9wfrhrF6udKWK/SWKHx0QAmF+ph/QsB/kISCB6hFfXz
+f5LjAwGa+rVBeE1jt8KPel5Qiyp+doY0NUI2DKBGvfTTFfXqVQXB9KT+CK8ueaDKi8FDBYVcGM9AdXrV
+vgAlP52XPoef8+cNYPpnlEwPgtHnDxgJn88bH8LPtXPJxJFDuw/7bayXD6PTcrLzmGh3ar+dk1NxrQ6s
+R/ChOH0MLhXSLz4S9+wsnF7cNNMATt21+bTmng64kwAqPzFFYG2yveFHmbL3nl6EpCmM5F7MapvGesUy
+sGroVamFuhzf9YfTxGyXNDjL1mT1a39JSWJDecr2HPXEgjys2nqwadyZTS686XDuqg55WddIQSw+TTrq
+G3nwxof2nn1MjWx9bqeIe8cy/nbYeqL/W+rQVT5Fe1q5qqffXVfN4VSj7ve0eksZe0svTUGKAocUQl6B
+vMb0Im4+oXqw+xTfVQPKxlQ14Mwkk64KQ2V3FTG86i3c4pQjTypHn2lqR46YXcRXaa7Q9cOtNzf+/EEB
+PM8qtNa4heNFPMpXvGDebZGmKrq8S4qcd+RJQlhaPbhKsn2k2i4nlMu9q+7mHdywppZqlVF9tbOjPHN3
+QOAqatpTMsu4QOL/oJ+yB2gziRRUK2r0IEPu62A5XdKHcVHdJAIJNypwEIXgTBFSqWFG2eY4D7W1R630
+neawjdRqZbRfcHrapLkS0bgxSytLnNTtxBxFntpNvmIO9X0XGlcK8NkR+ed7uESZMsGbfLXIkB8TXCz9
+gSvGLeBAjI496mw0GZjY7KGicdjU9H+fkURQx8O12GrECDIh/HHVDfkmzHYzOhwB8vrChaRSA9hC6Eog
+fFoJwG8Q8yay6iipj8QzgLyDdM/zHq75oKLDZqcd3QOR4Tqz/m0lCsgnVkHaA0H7Cb0iwUMN1LGa0CuV
+4t6Xjh/Ri6KfEJbnpH3BGLfQsvVq6li+soivGqXSPBJIBm0HVRjpwvvGNsnIoTrwcItrxUJMHet2GG7U
+CcMDWbOb3SI2cE8Di6Ub6h4gXHS1kj2mQjQ29Sk+sNdLXpOhh1XclzSiCf99tC8yvcU4bC6QUVGMlQzK
+cyJByRyeJUWECOCcSpPHZKqNEnUM23dVMqUhgRs1JvwacdcSnsDWnQxncwd7h82RJVAU1kNGXQmMjSYT
+plJYh9sIVDVmNyJK2MtofbQ9JDxgfNyye5wfX2M3aA5TKUpsmUbDtJEgbw+RA0f1m+/Wh44vY4o7jUck
+N0/sOSufm0X4f5s086z6jsHVdf+4KRmDRltdAcU6UvgxUmjMF7z4JaQY8GwRGDAOXcb7rUDaNzLjYZ7h
+ySnuQcJ0jOJElwq97lCJMg2ZxiCfLaYxHSaiqERG+U2mmkwZQUGZKOu5KDgAXZ93O2jkFHQ78olb6Nk3
+CJFSk/TYARhNoToycoWXVr4L1Ygt/DuMIvqavkCok4PhR+V31ytDwOomKsMUMcLIASZAjy9BGSSJrJcc
+BBSlj2wCHnKeo2dC6ityNRNBA6cdtXaEjJMtHjqIPnxtsPrR1C0N95MLxVQmOAYftnUM6vtonr9BhmUo
+BPFQ8NdHAcJtziqpp477iEE5f400GHrE9TMO3UO+9JCx0sP1nTGCRzj1WpOSk0vCWjCHbzSDMmYcXvd0
+UVJCp5wYVVK5YK1y1DN2YlwEQGkfneMnZ/xcnHhrPfGzIRQg8XqOL420b6KLDoBzAVLA8lSETISmUyha
+0q+goW1ZKqW86CYCnJwUFa02D6z9T2L/fsKfT05q/7/8+NdEgl0rEfB3CtgkQ0K1wIgtvX5aGar0pKIL
+38i9/Y64FrSE2ZUZcUD0PezZ29PZEt7XR87T9XX2+tpMstvgmA/AGNrbR+72O+q1FacVFvE35C45MJNp
+X1+7kkuGCiHiQThBk/8CbBciZJNxOQ6cBxuVQck2Atzra6oOlwWm78XabPMqjKu2WRn8C63eFsZx+8iL
+bQ1SRZ+wLijXKD4lagu9psCB8rFQWpxrMluMZMpyPa2k49ONz+p8RBHACSVrGh0LfzlnxBQ+/enVHhXl
+LuKbamdfLnsbY/YXkUNndSDjgPOMMt1KgsJSLBdFF3tu8Dy+ns2IVkcTeXqdg/Y1E83nqpkcbrKzuTXm
+xE9wtkwpNuluTjX9NqYDItD6hkwUQ8NogrujVJBK6WEV1eMGEwd4SVMRX2S8XjQl9V6bksxGpE2y1mW5
+BskTi0hYyoR431qcwIDQFgujkczq2PyNA6nUR0cKRKZDP5gnp5fkSJ8WYf0u0zn63WDiptp7ImA5MhV2
+hYT3o0k8EPYniJaSwCkkjQthD/Jxa9FmednJJSRmqRX2bS/aTo5ibh9uCEEB16BjOtyzuxFvP+F5B3UJ
+ZBYZkcMMdRPU0RNQxPRjkZB7yJjCJ0D61Cwkpr6LCudv3WPgU19ZwOoM79HgxLwknFPEWr+GXYmiZmvc
+OFZBosv+LNFwWK6mg3CgSttZCXA1HcOyDQ89TQLhzDDp2Zb7b8y42+XpBabjAL7ksWVYThP8kBPLCTOq
+rVtoZ9ocmDyKf9jabj1hg/kKxiNWHP70lCVKsFqtRXuiaHyOl7A0POBTHbYtOv5VO2ytCF9bK8fZyxES
+OA4r7hcp+wDf9PVaXETFyulKio7xRuTfEY87X9g205c3wi2DP6dprjmC9Mwo1hN82bpx9M4Q62o6ms4e
+pok9z5gXAb+RMJg30eW8YAQMLxswd3bYejDm831HsDeVNUOFSVvEXgG9BoywYMShi7FclDIA8qYI3hah
+JzgVcVmWCKDw8eNNAgc0o5+o/SNGZyRmdsWykZPP2Qi9EjTi4U8EToSCQOuHLM3mJRIR6dk1gN3NvZyb
+k074O5YRMJBzl+V5IjgEIJnZRdZmQ+QG42EsCBncNMdoJc9z3FJMBFbbcDDxNGJYGYxd61mViY/bjfbm
+7DIvnAwU/sJMkxF/RPOQybaX8DR+3djvnfHro1XUunfcOos7KzHOVuxBbTMmHa+vhe1KGBA1vHagtW+E
+VPDjnUIJAcZ3NJVqfjM4pT08jGLM3rbTji2ZJAsZPBNzvKTh4F0etbtrw5X+aUJgxZxrnOMiFmO8XyeN
+3touE1+0NbyzhDU2CckxQUMTpU3smSltynpYdGbAw/tG9KRGtepCPcTapK5oEADP6JJ5wsVjyMtd0SZh
++NmQqWewuh1P9FuitzIb5TJMMUavrJrvoYGR/spcIKX21RGUVyesExjs7zvUiBvooTBpjZz11t2Y1s1F
+I3LDTaP2TISeTDjnfnKHQocpipYQBwkZj5FdZOaE4Pei9DjnWJvZ6llzzmCYoJTN3Ah/Bi+wUW8JT55l
+h3GBgLeatnK/+LC9hZzx0aMPIWcsYPsEJCTqCbC6SQWuL/raLivtqAkzewJI3hDeTmb33bVyEyoOjssF
+a3nyZkNjBIbT5pIwjze7Rv3RzXDbTaoECNYykFgDBgtqS63VxJ3V9CPJSffVCJUZcbwtSsQRRMWiDcE6
+ot8c6dIoJ73LsSs8WCbxDYKRebFVvESwxmAwCcBg9WjwTAXi46bXNi+Cue4AczWo5ginHGY8WWhM5nwH
+FjGhw+Ru9iLWcHwwx+2mGTjQPhIYiGSZxQVKN2T9iOjTZB0jgOSAvBcsqa+14+F1dWlCDO84EklUmWsZ
+PNpJkSBgxlCXZtQTsZKLKFvm9VJ89qGifO4AKfKAp8Nkifdc5SQnyWpm3XkJLnFue/YmwY/pOdYr9PXp
+Nq/CgPS8JjcETOOZmGIXn9FSPC4LcisrqzH4TlamtRS8QPp1WC/tZ5VmRDU6b63tSD5F855cDrF9Ufp7
+wmtinNqieHh2cdj/A8hdu3iU0wIA
 `,
 	},
 
 	"/includes.tmpl": {
 		local:   "../ui/includes.tmpl",
-		size:    10123,
+		size:    13618,
 		modtime: 1456280857,
 		compressed: `
-H4sIAAAJbogA/7xa3XPbuBF/z1+B8OxY6ll0Ls29OJIyrX1pPZPrZBJl+tDpZGASktBQJI+A/DE6/e9d
-fJEACJKSfXMPcSRgsVjsx28XC01ZUtGSI5rOorIqljQj1YJsygxzEiH+WJJZxMkDv3iY/O+3LakeJxwm
-o/mLFwhNU3qHkgwz1qydVIRtMw4ECHVQ3G45L3I0PUV0iUb/xOx6W2Y0gQ2vtowXmwXd0HzFxmiHTuf1
-qntc5TAMq/YwDKJRnoFs09MZ+hfeEDGm9oRdWYnz1rb5dnNLqpoIyGDprzSnnzRFvCyqDebX2wpzWuQj
-8+FXmmWUkaTIUxDqdN7Bf5tTOPaGTS/EdC2L++2gM3fJn2QEwwFeap5aFUrRF6BpaZQOpZdFuS0bDYVI
-aL4sbPWEpQBdW0RKi9oAHUuKO1LhLJukWp/RfPRk1W/Y2NWor+EuuRmpQIwJp0J8uT1O1jQnRnTpkDP0
-heOKkxS9N59iXnxdXH3hFZhnNEaX6OxMWMizsVR+j2qLLS+33FYux7cZadFx5Qaugvma4NQeEWOVOyDJ
-QCz40x43sUJztLEUGs2NRbrWtZS4Lu4nm6IiUSfLeyrWrWmWVmSIrzDGZFkVmwkTykbH7COWIbmsvQkE
-xreYgH1HdnCBQTk7R8ttnkgfO4HV/BydCI9WcXew5tAoAYdGSbHN+Vi5k2QDzvKxuCfVFWZkNJZ+EpBt
-P37nbwZ0jkHFOs/oU35bpI8+My+MBDaPoh+UG9Uwfo52auTyc1HAkUu8IpffGMmW+7ErCezr7zLlS1h0
-gPuloI9MhMUsehOJk6dBmm6X6l8eUJErGAyImNIgaGNtAGKHAjGRS3Q8SgS7o+TeA75nexno0c5jkja+
-El4l0bTTrZBwP4ZYSYByCEsVV/NVoygqlogXHGeoIpDWGUcKGV8M2auVRRtPlLIC+0vfbi3wOort4WdD
-FyiUONBf0E+vXw9m7lM/kYSkb3lhK5xrN2wIxLTrlFcZBdMd5ZE6NTQgmUgez0wZAKGPmS7xJjijq/wy
-I0vQhuKOyB387YDxZ6SPfvh+KhiaeHQ8ZkW4o21P93ao3uFsG0oFIkz1WbT9FGlM2aKidxSiyCtWuRo2
-xeohQJjhW5LpXKK463A6FEmb8sojrr1O8zWEaD5Dr4OZr6uIUoFSl58DsenuNg5uY5WwjsGPOjE6oJA4
-7og/HndGuWvggMNZLFwQtNLwAKiESs6M5t9tVJhitK7IUmUa50xsjSvytcpGN+lYXq3acQsEE++Gx6A2
-wxVE1yz6dpvh/Hs0l3TTC2xfsdwc+VEIZYeckLIzO9oSS8qgdDpVi/m2SHZe8iXzgTvoD7xYreDaBcpv
-aVRKGKafrGmaknwCJc12k4OqUsyxmespgSQZrCUTAcizaEkg7SPDxXUcscJMOb6DvQSlqyANVeGk038a
-DWf9x3CJrEOIj6ie1fWOYsIQzh9RVdwzJC8OrzL+TiZ9LayTxlXh0glzQo1mGy/mfI3s3YQduMu1b3bN
-xHBhGQxI0T2hpLHi1A1pL8d56Tqcqiu6WgNaMU7K6W2FLuYCCJGVW+WgvKuITo762pW1e6sBwQJYJt8b
-lu56G9i8hO0n655EbetzJC4rdn4G4KBtkOi4AilU+Acu7VvQ6pLFZUXuYLh18+nls2hdqOglPUfskrX5
-SOdgcQ46hH1CcXasyJpVYCvXkT1I87KIn0PKdrSr4JmscAnIlXNMc7dhNgQQYiWAnULtH0RCaEISiTkr
-FKcXpRNYTlYzwQaxKduT4qPVqPTut0NtSrt8myG1OL6pa7f36MxXwZlp9BjIU/dAsbfNIm2Ku+6Srrnh
-Sc/Qa2Xf6dUrZH2NM5KvIAjn6O3PuqC0NtONKr+kDGctmkP24VG7fqkIzFQ3ctqIck1Kvh43XS3k7Rqz
-jCZk9Pr87c9jJcHR54jj2O1Wmrqot5A1mqvrPHFpXVO4+ALgyYRRbLnMIKbhdNbkBwtoB4o4owb9/d+K
-75Vm6bcgjzhAX0MrfCacp4hyVp/nqWf4Y4X26+smiYt0QzIIbJIiRvOE6NUgi8pAJB3w1OPqbX1M2Zvt
-PWOTY0SzSzio+D9+QqfGdXcnQ/1H0v7XJu5TrBVWnQ8AmqWDSfrlo8GkWqNtPNZFhrSd80ZygFZbytHC
-tHpH1DlLSH4DAXYXq+5bHamHdxDSmkT3vVLCSQKu9fLM18jBgXLQWWumo4OP63rL0PGe8twzrvMnboW1
-oCAZI45Dzj2CU5NrTa2gSjcnaMxZNQzZUSKhyYmPI3vQSLHQbWj511Q2jVRdmT9Yjh2T/6V5KDpFb9Bs
-hn7y+jZFmtYPjKHEz+JPcMU13aOh/O88qIk7wbzNw6T1wSck0yHtg9HFoTjKwhB62MtmS1anBFXV703j
-+H1OrqEtml9//fTx5upvi18Cb5vGy1h8BeG/AFvryugli395IMmWEzn4++82yUwssKffoygCCI3QBP79
-6E2ezts3Pm0It3xj8QdaMf6B8GQdzrbitEtBg1SjBC0FLUkv9SH618NiULQp8obt+LxHaud+a1UHIU+U
-YWUjbVm1u9TyjghVNE70M6v0tOT7Qox8yWlZEi7dHRZ3sSIc4rmiOUcZhthrmR4YJ0VKJHvAK5bgkoAm
-rorNBuon9VSryllFZu8VOLCGv17AsS9lh6NNrRVxoRJgoH1o1bRgp+it8hgUuoUxt9ofxhjQiMX8qJ8q
-BKtD1+Y1hK1iXpSfCWZFbrrU6NUmxWz9DvnzRhoY+kAfSDp68yz37LeWvOEeZKnOdxbd3ZRXqIda8W4/
-o7L7NOu5wHCvGwJf9A8IAhO/ZfU7VXt2IV/luuc/6/e6L+3HEzXNSrj8kK7562KDIFLKDGooe7bp3zjd
-G9O7MTOmoVA3EzoMURX3B5uhasOO6fye7Bz0KzFf77WB3sM2J7ubdB/NT3bCAvtQRRSIGMfAk1RIOPf2
-Ubfka5gaaSuO98cwVW+pJ7sQON/kYP8/jJvFaLcDbHEet/b7I3foezj7+6NQsvt6dh5pb4zGqqB40rme
-sqty8j95Wzt2AlvvdqL2tpVuftDwV/sHDbvdBV1KKhVzdQT9PwAA//+ZVZzGiycAAA==
+H4sIAAAAAAAC/91a3XPbuBF/91+B6OxY6ll0ksu9OJIyjZ303EvSTKy0D51OBhYhCTVF8gDIjqrof78F
+CJIACH7IzqWdPlzOIoDFfv52scCIzxhNBaLhuJeyZE4jwqZklUZYkB4Sm5SMe4J8Eadfhv/+bU3YZihg
+sDc5OEBoFNJbNIsw5+XaISN8HQmYgFDNjOu1EEmMRkeIzlH/F8wv1mlEZ7Dh+ZqLZDWlKxovOPr6FcHg
+R7IAkpwmMR+gLTqaFHTuMIthItDZwWdglooIuB0djdF7vCLwrdyiQgX1WfaJhAgvMI25QNeYk4jGZJCT
+zIQAMXiK44oc8Xp1TVgxCabBzu9oTD/oGcE8YSssLtYMC9i5n//xjkYR5WSWxCFwA7z46a9jCnpc8dGp
+HC54sX/dU4l1Es0igkGkR3oXrYjMlqdgTGX3GrumSbpOS535ptB4npgK83MBxjMmZXrVFq1ZktwShqNo
+GGoN9yb9extjxQe2jl2d1/HNCQM2hoJK9tX2eLYEd8pZVz4/RlcCMwFe9zL/KxDJp+n5lWBgsP4AnaHj
+Y2khx+pK+Q2qTdYiXQtTuQJfR6QyT2SOYStYLAkOzS/yG7M/qGnAFvxT/Z4HH43RylBob5JbpG5dRYnL
+5G64Shjp1ZK8o3LdkkYhI210pTGGc5ashlwqG+2zj1yG1LLqJhAYnwMC9u2b4QYGFfwEzdfxTPnYIawW
+J+hQenQWd501h/ozcGg0S9axGGTupMiAs7xN7gg7B6zqD5SfeHjbDV64m8E8y6BynWP0kbhOwo1LzAkj
+Cf/93g+ZGxWZ4gRtsy9nH5MERE7xgpx9Bjid7wYVTjLMOo8oiYWc7lHN3tuW1Fo339nfQBGu2CMxB0Id
+4iEEA0UyTse9Zz1pitA7p97Hm5d7bGYzBh9kkGtUNtOBlQUcBdcgw0wt0QChIPWWkjsHiR/s9qBHM1Or
+ucG5dHMF77V+jmQ8cMRTMHNrps2o5j81rKNkjkQicIQYgVIGEn4G1Qdt9qok+tJHFa9A/sy1W/ggst1l
+Q6fIl8nQn9DTJ09ai4sjN7P5uK94YQVfCje0Y6zilJUypM0lWbmgxg8NkqYHMp/ftZskwtckQtV6qGIi
+JdEhC36lcYjGY3Sc57njbOMi7UlVkIiTygIr2rheNjoaIpj0vj4ASoJyPhckRcairObdebA/9GJwK0Od
+nVgFAwv+jqO1Knb6eTGN9Mir/PfRZODlxxBrr10bY0Vz1L3KdhhvIZ4L1Z1+nexHf0DEPfocUP56lYpN
+f4plFoDDgPHtHRE4xAIPusWiwIu6IJTUzei7lTqHBHBDNg+Iw14WDkAkK3OqkJpNuNU+tz9umTLk2viv
+ySENow8B2cYDOCJo4c7QX6/+9j7gapjON8WM+0jdyXeyemqv+kEjRwmfM0XjgScOqMA3kW5CDHFEF/FZ
+ROYQUhl1RG7h35pTwANOH83V/31r6cLZTFhZEGFp29F9xSG9yMxyWXJkV1PBpaaM3lKoeZzmicg+u52O
+Ts481m4Z6OKna91bns6dyWU+yujmE9FkjJ54D06oEW2L7kULgNu7DbzbeNKpL6E2S4w6nEP3E/HH/WRU
+uw66COL6dg1uuocmB1T8OAI1yi1daGXbkOJraWj8iItFTvoZojrqwXSTGg3A2mnvAVR+SVJQnkjgKKZb
+g/Lo00zeWVeWXScH3Qv5euYZjvmcsCv6HwL1/dMnz563Fhi/vsoLGKFXMxIa1jHaR65Td0gA6PFjRxkf
+CU/WbEa+TW6ofGc5+T8oa+T0v3Pi2KPndUOj5HojpAY4+MF3S0KFYf+301DZQ8grwGDNIkWjMTOU04WG
+CB02xsi3TGsPzUD/x5mmRZBujHdkykbS75QEXWD15Tg4Pd6YIDfCaMnIPGuOWZLxJWbkE4v6l+FA3XdV
+YQQmDJ2LOA6RghlE97j3+TrC8Q3AiZw3OsVWPrDaem8lU2bASy5rG3omx2qmlzvdXpDjVZbMVprLmXt6
+8bqqSBaLiEjlVzSqOPTPHy5pGJJ4CCl8vYpBVfIAmI81oLSaBmvJUOaXcW9O7qBiyKnYjiNX5EOW72Dn
+lKZ7ZBoo/SevZmk0mDaLYU8yhJB/omJUw2tGhCMcbxBL7jhSly+PI/FChZ5m1uo8Zr3WWlCUasy3cWLO
+1cjOPrV67sOqt2PlQHsv3BuQ8pKbGnXHyA5pJ8c61Ye/8mB0sQQglc260TVDpxOJ0cioE9RHdd8jk1L2
+s64CaSxuJAkgObspSdrrTWBzCga3WGgoFEx99tUNjgEWABy0ChI19zkZKvwFp+aVzuKMBykjt/DZvcBp
+pjOt3A7RM3qC+Bmv0lHOwYMYdAj77HEFVcuyJuXZynZkB9KcLOLmkBTVRPBwgVNArlhgGtvPENoAQq4E
+sMtQ+weZEMqQRHLMCMXRaWoFlpXV8mCD2FSvSOSfxnsS57Ku7TWJWTyOUbY4uCwqx5fo2FXBcX5ZnkNe
+dnUl9zZJhGVp2aWgVJ6h16qmOhx+jJ9BROIFBOEEPf8ZlW17c0FxHGx5QUJjyD7C08ZmBEbYpRrOWbkg
+qVgOnIrV5ItHdEb6T06e/zwoj757yREEgf3iI6+LGsveXHNF5Sbv2ZaUI3U7IRNGshYqg+SX9sdlfjCA
+tqWUy9Wgf/8jo3uuSbrPOPYQoOlRgF8mHIeICl7Ic18Zvi3TbulfJnGZbkgEgU1CxGk8I3o18JJlIBK2
+eOp+RwEtpnrf0ihjmWPknb10UPn/4B6Xy7a7Wxnqn2ruv8zJTYo1kKP2WZUmaWGSfo5WYlKhUVxXZCjb
+WQ/XOmi1ohzNTOW6m1qy+PjPIcC8eDduGvfSwwsIaT1FX9WHRJAZuNajY1cjnQOlk6wF0X5ncW1vaRPv
+Pk/mBgdOVWuEdfWuU90B2ROO8lyb1wpZ6WYFTS6rhiEzShQ0WfGx54MalJHQr2nUv3llU3JVl/m95dg+
++V+Zh6Ij9EzeTT91ukZJGBavPn2Jnwcf4Iib967a8r/1KFGeCSZVGnlab32GV9xMN8DotCuOcj+Edrtp
+rvBa6c1zqK4Kx29ycg1tvcnFpw9vL8//PH3teR+aexkPziH8iwb8GD3iwesvZLYWRH38+tWcMpYLzOGX
+qNcDCO2hIfz3ozN4VP8e0i7fePCGMi7eEDFb+rOtlHYu56CsUYLmci4Jz7QQzeth8YoXRV67HR/29Nc6
+3xrVgc8TVViZSJuyajtenRGhisYz/VRVedrsZiq/XMU0TYlQ7g6L60gRAfHMaCxQhCH2KqYHwrMkJIo8
+4BWf4ZSAJs6T1Qrqp+ymOytns2nmXh6BNfw1Ao55KOuONoVW5IFKgoH2oUV5DzlCz/XdkO8Uxu1qvx1j
+QCMG8b0egHurQ9vmBYQtApGkHwnmSZz3tNHjVYj58gVyx3Nu4NMb+oWE/WcPcs9ma6kTbidLNbwMU91N
+dYT6Uije7mcws0+znEgMd7oh8EM/wvYM/BYVT+uqo1P1kLB+/KN+YnhVvQjKhnkKhx9SN36RrBBEShpB
+DWWOlv0bq3uT924OnIZC0UyoMQRL7jqbgVVhJ+/8Hm4t9EuxWO60gV7CNofby3DXmxxupQV2vorIEzGW
+gYeh5HDi7JOdki9gqK+tONjtQzR7/nm49YHzZQz2/2bUDELbLWCLdbW22+25Q9PrkVcbqWT77u6kp72x
+N8gKinvJdZ9dMyf/ztuasePZeruVtbep9PwN9k/mG+zt9pTO1aws5ooI+h2h+P5cMjUAAA==
 `,
 	},
 
@@ -0,0 +1,331 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofiler
+
+import (
+	"container/list"
+	"encoding/json"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+const (
+	// statsWindow is the number of recent profiles per route that
+	// percentiles and averages are computed over.
+	statsWindow = 1000
+
+	// statsMaxRoutes limits the number of routes tracked, so unbounded
+	// profile names, such as URL paths with IDs, cannot exhaust memory. The
+	// least recently profiled route is dropped to make room for a new one.
+	statsMaxRoutes = 1000
+
+	// statsSampleCalls and statsSampleSteps are the number of custom timing
+	// call types, most called first, and of slowest steps kept from each
+	// profile.
+	statsSampleCalls = 8
+	statsSampleSteps = 10
+
+	// statsSlowestSteps is the number of slowest steps reported per route.
+	statsSlowestSteps = 5
+)
+
+// Stats maintains per-route statistics of the profiles it is given. Routes are
+// identified by profile name, so set Profiler.RouteName or use a router
+// adapter to group requests by route template. Set Profiler.Stats to a Stats
+// to serve them at stats-index and stats-list under Profiler.Path.
+type Stats struct {
+	sync.Mutex
+	routes map[string]*list.Element // of *routeStats in lru
+	lru    *list.List               // most recently profiled first
+}
+
+// routeStats holds the recent samples of a route in a ring.
+type routeStats struct {
+	route   string
+	count   int64
+	samples []statsSample
+	next    int
+}
+
+// statsSample is the part of a Profile that statistics are computed from. It
+// has a fixed size, so the memory used by a route does not depend on its
+// profiles.
+type statsSample struct {
+	duration float64
+	calls    [statsSampleCalls]callSample
+	steps    [statsSampleSteps]stepSample
+	ncalls   int
+	nsteps   int
+}
+
+// callSample is the number and total duration of the custom timings of a
+// call type in a profile.
+type callSample struct {
+	callType string
+	count    int
+	ms       float64
+}
+
+// stepSample is the total duration of the steps with a name in a profile.
+type stepSample struct {
+	name string
+	ms   float64
+}
+
+// newStatsSample returns the sample of p.
+func newStatsSample(p *Profile) statsSample {
+	calls := make(map[string]*callSample)
+	steps := make(map[string]float64)
+	p.Walk(func(t *Timing) {
+		if t != p.Root {
+			steps[t.Name] += t.DurationMilliseconds
+		}
+		for callType, cts := range t.CustomTimings {
+			c := calls[callType]
+			if c == nil {
+				c = &callSample{callType: callType}
+				calls[callType] = c
+			}
+			c.count += len(cts)
+			for _, ct := range cts {
+				c.ms += ct.DurationMilliseconds
+			}
+		}
+	})
+	var cl []callSample
+	for _, c := range calls {
+		cl = append(cl, *c)
+	}
+	sort.Slice(cl, func(i, j int) bool {
+		return cl[i].count > cl[j].count
+	})
+	var sl []stepSample
+	for name, ms := range steps {
+		sl = append(sl, stepSample{name, ms})
+	}
+	sort.Slice(sl, func(i, j int) bool {
+		return sl[i].ms > sl[j].ms
+	})
+	sample := statsSample{duration: p.DurationMilliseconds}
+	sample.ncalls = copy(sample.calls[:], cl)
+	sample.nsteps = copy(sample.steps[:], sl)
+	return sample
+}
+
+// RouteStats are the statistics of a route.
+type RouteStats struct {
+	Route string
+
+	// Count is the number of profiles seen. The other fields cover only the
+	// most recent of them, up to 1000.
+	Count   int64
+	Samples int
+
+	MeanMilliseconds float64
+	P50Milliseconds  float64
+	P95Milliseconds  float64
+	P99Milliseconds  float64
+
+	// CustomTimings has the average count and duration per request of each
+	// custom timing call type, such as "sql".
+	CustomTimings map[string]CustomTimingStats
+
+	// SlowestSteps are the steps with the highest average duration per
+	// request, slowest first.
+	SlowestSteps []StepStats
+}
+
+type CustomTimingStats struct {
+	MeanCount        float64
+	MeanMilliseconds float64
+}
+
+type StepStats struct {
+	Name             string
+	MeanMilliseconds float64
+}
+
+// NewStats returns an empty Stats.
+func NewStats() *Stats {
+	return &Stats{
+		routes: make(map[string]*list.Element),
+		lru:    list.New(),
+	}
+}
+
+// Add adds p to the statistics of its route.
+func (s *Stats) Add(p *Profile) {
+	if p.Root == nil {
+		return
+	}
+	sample := newStatsSample(p)
+	s.Lock()
+	defer s.Unlock()
+	var rs *routeStats
+	if e := s.routes[p.Name]; e != nil {
+		s.lru.MoveToFront(e)
+		rs = e.Value.(*routeStats)
+	} else {
+		if s.lru.Len() >= statsMaxRoutes {
+			e := s.lru.Back()
+			s.lru.Remove(e)
+			delete(s.routes, e.Value.(*routeStats).route)
+		}
+		rs = &routeStats{route: p.Name}
+		s.routes[p.Name] = s.lru.PushFront(rs)
+	}
+	rs.count++
+	if len(rs.samples) < statsWindow {
+		rs.samples = append(rs.samples, sample)
+	} else {
+		rs.samples[rs.next] = sample
+		rs.next = (rs.next + 1) % statsWindow
+	}
+}
+
+// Routes returns the statistics of each route, slowest 95th percentile first.
+func (s *Stats) Routes() []RouteStats {
+	s.Lock()
+	defer s.Unlock()
+	l := make([]RouteStats, 0, s.lru.Len())
+	for e := s.lru.Front(); e != nil; e = e.Next() {
+		l = append(l, e.Value.(*routeStats).stats())
+	}
+	sort.Slice(l, func(i, j int) bool {
+		return l[i].P95Milliseconds > l[j].P95Milliseconds
+	})
+	return l
+}
+
+func (rs *routeStats) stats() RouteStats {
+	n := float64(len(rs.samples))
+	st := RouteStats{
+		Route:         rs.route,
+		Count:         rs.count,
+		Samples:       len(rs.samples),
+		CustomTimings: make(map[string]CustomTimingStats),
+	}
+	durations := make([]float64, len(rs.samples))
+	steps := make(map[string]float64)
+	for i, sample := range rs.samples {
+		durations[i] = sample.duration
+		st.MeanMilliseconds += sample.duration / n
+		for _, c := range sample.calls[:sample.ncalls] {
+			cs := st.CustomTimings[c.callType]
+			cs.MeanCount += float64(c.count) / n
+			cs.MeanMilliseconds += c.ms / n
+			st.CustomTimings[c.callType] = cs
+		}
+		for _, step := range sample.steps[:sample.nsteps] {
+			steps[step.name] += step.ms / n
+		}
+	}
+	sort.Float64s(durations)
+	st.P50Milliseconds = percentile(durations, 0.50)
+	st.P95Milliseconds = percentile(durations, 0.95)
+	st.P99Milliseconds = percentile(durations, 0.99)
+	for name, d := range steps {
+		st.SlowestSteps = append(st.SlowestSteps, StepStats{name, d})
+	}
+	sort.Slice(st.SlowestSteps, func(i, j int) bool {
+		return st.SlowestSteps[i].MeanMilliseconds > st.SlowestSteps[j].MeanMilliseconds
+	})
+	if len(st.SlowestSteps) > statsSlowestSteps {
+		st.SlowestSteps = st.SlowestSteps[:statsSlowestSteps]
+	}
+	return st
+}
+
+// Baseline returns a Baseline of route from the average of its recent
+// profiles, or nil if it has none. Its Steps are those among the slowest
+// steps of each profile.
+func (s *Stats) Baseline(route string) *Baseline {
+	s.Lock()
+	defer s.Unlock()
+	e := s.routes[route]
+	if e == nil {
+		return nil
+	}
+	rs := e.Value.(*routeStats)
+	if len(rs.samples) == 0 {
+		return nil
+	}
+	n := float64(len(rs.samples))
+	b := &Baseline{
+		Route:              route,
+		CustomTimingCounts: make(map[string]float64),
+		Steps:              make(map[string]float64),
+	}
+	for _, sample := range rs.samples {
+		b.DurationMilliseconds += sample.duration / n
+		for _, c := range sample.calls[:sample.ncalls] {
+			b.CustomTimingCounts[c.callType] += float64(c.count) / n
+		}
+		for _, step := range sample.steps[:sample.nsteps] {
+			b.Steps[step.name] += step.ms / n
+		}
+	}
+	return b
+}
+
+// percentile returns the nearest-rank percentile q of sorted.
+func percentile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(math.Ceil(q*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	if i >= len(sorted) {
+		i = len(sorted) - 1
+	}
+	return sorted[i]
+}
+
+func (mp *Profiler) statsList(w http.ResponseWriter, r *http.Request) {
+	if mp.Stats == nil {
+		http.Error(w, "", http.StatusNotFound)
+		return
+	}
+	j, err := json.Marshal(mp.Stats.Routes())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(j)
+}
+
+func (mp *Profiler) statsIndex(w http.ResponseWriter, r *http.Request) {
+	if mp.Stats == nil {
+		http.Error(w, "", http.StatusNotFound)
+		return
+	}
+	v := map[string]interface{}{
+		"path":    mp.urlPath(r),
+		"version": Version,
+		"routes":  mp.Stats.Routes(),
+	}
+	w.Header().Set("Content-Type", "text/html")
+	if err := statsIndexTmpl.Execute(w, v); err != nil {
+		log.Print(err)
+	}
+}
@@ -1,9 +1,11 @@
 package miniprofiler
 
 import (
+	"fmt"
 	"html/template"
 	"io/ioutil"
 	"strings"
+	"time"
 )
 
 var includePartialHtmlTmpl = parseInclude("include", "/include.partial.html")
@@ -24,3 +26,155 @@ func parseInclude(name string, fname string) *template.Template {
 	s = strings.Replace(s, "}", "}}", -1)
 	return template.Must(template.New(name).Parse(s))
 }
+
+var resultsIndexTmpl = template.Must(template.New("index").Funcs(template.FuncMap{
+	"started": func(ms int64) string {
+		return time.Unix(ms/1000, 0).UTC().Format("2006-01-02 15:04:05")
+	},
+}).Parse(`<html>
+    <head>
+        <title>Profiling Results</title>
+        <link rel="stylesheet" type="text/css" href="{{.path}}includes.css?v={{.version}}" />
+    </head>
+    <body>
+        <form method="get">
+            status <input type="text" name="status" value="{{.status}}" placeholder="5xx" />
+            tag <input type="text" name="tag" value="{{.tag}}" placeholder="key:value" />
+            {{if .name}}<input type="hidden" name="name" value="{{.name}}" />{{end}}
+            <input type="submit" value="filter" />
+        </form>
+        <table class="profiler-results-index">
+            <thead>
+                <tr>
+                    <th>Name</th>
+                    <th>Started</th>
+                    <th>Machine</th>
+                    <th>Status</th>
+                    <th>Content Type</th>
+                    <th>Size</th>
+                    <th>Tags</th>
+                    <th>Total Duration</th>
+                </tr>
+            </thead>
+            <tbody>
+            {{range .profiles}}
+                <tr>
+                    <td><a href="{{$.path}}results?id={{.Id}}">{{.Name}}</a></td>
+                    <td class="profiler-results-index-date">{{started .Started}}</td>
+                    <td>{{.MachineName}}</td>
+                    <td class="profiler-results-index-time">{{if .StatusCode}}{{.StatusCode}}{{end}}</td>
+                    <td>{{.ContentType}}</td>
+                    <td class="profiler-results-index-time">{{.ResponseSize}}</td>
+                    <td>{{range $k, $v := .Tags}}<a href="?tag={{$k}}:{{$v}}">{{$k}}:{{$v}}</a> {{end}}</td>
+                    <td class="profiler-results-index-time">{{printf "%.1f" .DurationMilliseconds}}</td>
+                </tr>
+            {{end}}
+            </tbody>
+        </table>
+    </body>
+</html>
+`))
+
+var statsIndexTmpl = template.Must(template.New("stats").Parse(`<html>
+    <head>
+        <title>Profiling Statistics</title>
+        <link rel="stylesheet" type="text/css" href="{{.path}}includes.css?v={{.version}}" />
+    </head>
+    <body>
+        <table class="profiler-results-index">
+            <thead>
+                <tr>
+                    <th>Route</th>
+                    <th>Count</th>
+                    <th>Mean</th>
+                    <th>p50</th>
+                    <th>p95</th>
+                    <th>p99</th>
+                    <th>Custom Timings</th>
+                    <th>Slowest Steps</th>
+                </tr>
+            </thead>
+            <tbody>
+            {{range .routes}}
+                <tr>
+                    <td><a href="{{$.path}}results-index?name={{.Route}}">{{.Route}}</a></td>
+                    <td class="profiler-results-index-time">{{.Count}}</td>
+                    <td class="profiler-results-index-time">{{printf "%.1f" .MeanMilliseconds}}</td>
+                    <td class="profiler-results-index-time">{{printf "%.1f" .P50Milliseconds}}</td>
+                    <td class="profiler-results-index-time">{{printf "%.1f" .P95Milliseconds}}</td>
+                    <td class="profiler-results-index-time">{{printf "%.1f" .P99Milliseconds}}</td>
+                    <td>{{range $callType, $s := .CustomTimings}}{{$callType}}: {{printf "%.1f" $s.MeanCount}} calls, {{printf "%.1f" $s.MeanMilliseconds}} ms<br />{{end}}</td>
+                    <td>{{range .SlowestSteps}}{{.Name}}: {{printf "%.1f" .MeanMilliseconds}} ms<br />{{end}}</td>
+                </tr>
+            {{end}}
+            </tbody>
+        </table>
+    </body>
+</html>
+`))
+
+var resultsDiffTmpl = template.Must(template.New("diff").Funcs(template.FuncMap{
+	"signed": signedMs,
+	"indent": func(depth int) template.CSS {
+		return template.CSS(fmt.Sprintf("padding-left: %dpx", 8+depth*15))
+	},
+}).Parse(`<html>
+    <head>
+        <title>Profile Comparison</title>
+        <link rel="stylesheet" type="text/css" href="{{.path}}includes.css?v={{.version}}" />
+    </head>
+    <body>
+        {{with .diff}}
+        <table class="profiler-results-index">
+            <thead>
+                <tr>
+                    <th></th>
+                    <th><a href="{{$.path}}results?id={{.A}}">A</a>: {{.AName}}</th>
+                    <th><a href="{{$.path}}results?id={{.B}}">B</a>: {{.BName}}</th>
+                    <th>Change</th>
+                </tr>
+            </thead>
+            <tbody>
+                <tr>
+                    <td>duration (ms)</td>
+                    <td class="profiler-results-index-time">{{printf "%.1f" .ADuration}}</td>
+                    <td class="profiler-results-index-time">{{printf "%.1f" .BDuration}}</td>
+                    <td class="profiler-results-index-time">{{signed .DeltaMilliseconds}}</td>
+                </tr>
+                {{range $callType, $c := .CustomTimingCounts}}
+                <tr>
+                    <td>{{$callType}} calls</td>
+                    <td class="profiler-results-index-time">{{index $c 0}}</td>
+                    <td class="profiler-results-index-time">{{index $c 1}}</td>
+                    <td class="profiler-results-index-time">{{if ne (index $c 0) (index $c 1)}}changed{{end}}</td>
+                </tr>
+                {{end}}
+            </tbody>
+        </table>
+        {{end}}
+        <br />
+        <table class="profiler-results-index">
+            <thead>
+                <tr>
+                    <th>Step</th>
+                    <th>A (ms)</th>
+                    <th>B (ms)</th>
+                    <th>Change (ms)</th>
+                    <th>Custom Timings (A &rarr; B)</th>
+                </tr>
+            </thead>
+            <tbody>
+            {{range .rows}}
+                <tr>
+                    <td style="{{indent .Depth}}">{{.Name}}{{if .Status}} <em>({{.Status}})</em>{{end}}</td>
+                    <td class="profiler-results-index-time">{{if ne .Status "added"}}{{printf "%.1f" .ADuration}}{{end}}</td>
+                    <td class="profiler-results-index-time">{{if ne .Status "removed"}}{{printf "%.1f" .BDuration}}{{end}}</td>
+                    <td class="profiler-results-index-time">{{signed .DeltaMilliseconds}}</td>
+                    <td>{{range .CustomTimings}}{{.CallType}} {{.ExecuteType}} {{.ACount}} &rarr; {{.BCount}}: <code>{{.CommandString}}</code><br />{{end}}</td>
+                </tr>
+            {{end}}
+            </tbody>
+        </table>
+    </body>
+</html>
+`))
@@ -63,8 +63,40 @@ type Profile struct {
 	DurationMilliseconds float64
 	CustomLinks          map[string]string
 
-	w http.ResponseWriter
-	r *http.Request
+	// ClientSteps are steps timed in the browser with MiniProfiler.step.
+	// Their StartMilliseconds are since the start of navigation.
+	ClientSteps []*Timing `json:",omitempty"`
+
+	// Tags and Metadata are attached by handlers with AddTag and
+	// SetMetadata, such as a user ID, tenant or build. Tags can be filtered
+	// on in the results index.
+	Tags     map[string]string      `json:",omitempty"`
+	Metadata map[string]interface{} `json:",omitempty"`
+
+	// StatusCode, ResponseSize, ContentType and ResponseHeaders describe the
+	// response. They are recorded at Finalize from Profile.ResponseWriter; a
+	// handler that wrote nothing is recorded as 200 OK, as net/http sends.
+	// Extensions for frameworks that write the response themselves set
+	// StatusCode and the others before Finalize, which leaves them as they
+	// are.
+	StatusCode      int
+	ResponseSize    int64
+	ContentType     string
+	ResponseHeaders http.Header `json:",omitempty"`
+
+	// Regressions are the ways in which the profile performed worse than the
+	// baseline of its route, if Profiler.Baselines is set.
+	Regressions []Regression `json:",omitempty"`
+
+	w        http.ResponseWriter
+	r        *http.Request
+	rw       *responseWriter
+	profiler *Profiler
+	named    bool
+	show     bool
+
+	mu        sync.Mutex
+	finalized bool
 }
 
 type Timing struct {
@@ -79,36 +111,64 @@ type Timing struct {
 	sync.Mutex
 }
 
-// NewProfile creates a new Profile with given name.
+// NewProfile creates a new Profile with given name using DefaultProfiler.
 // For use only by miniprofiler extensions.
 func NewProfile(w http.ResponseWriter, r *http.Request, name string) *Profile {
+	return DefaultProfiler.NewProfile(w, r, name)
+}
+
+// NewProfile creates a new Profile with given name.
+// For use only by miniprofiler extensions.
+func (mp *Profiler) NewProfile(w http.ResponseWriter, r *http.Request, name string) *Profile {
 	p := &Profile{
-		w: w,
-		r: r,
+		w:        w,
+		r:        r,
+		profiler: mp,
 	}
 
-	if Enable(r) {
+	if mp.Enable(r) {
 		p.Id = newGuid()
 		p.Name = name
 		p.CustomLinks = make(map[string]string)
 		p.start = time.Now()
-		p.MachineName = MachineName()
+		p.MachineName = mp.MachineName()
 		p.Root = &Timing{
 			Id:      newGuid(),
 			profile: p,
 		}
-		w.Header().Add("X-MiniProfiler-Ids", "[\""+p.Id+"\"]")
+		p.show = mp.authorized(r)
+		if p.show {
+			w.Header().Add("X-MiniProfiler-Ids", "[\""+p.Id+"\"]")
+		}
+		p.rw = &responseWriter{
+			ResponseWriter: w,
+			p:              p,
+			inject:         mp.AutoIncludes && p.show,
+			timing:         mp.ServerTiming && p.show,
+		}
+		p.w = p.rw.wrap()
 	}
 
 	return p
 }
 
-// Finalize finalizes a Profile and Store()s it.
+// ResponseWriter returns the http.ResponseWriter to use for the response, so
+// its status code, size and headers are recorded. It is the one passed to
+// NewProfile if the request is not profiled.
+// For use only by miniprofiler extensions.
+func (p *Profile) ResponseWriter() http.ResponseWriter {
+	return p.w
+}
+
+// Finalize finalizes a Profile and Store()s it if Keep allows.
 // For use only by miniprofiler extensions.
 func (p *Profile) Finalize() {
 	if p.Root == nil {
 		return
 	}
+	p.mu.Lock()
+	p.finalized = true
+	p.mu.Unlock()
 
 	u := p.r.URL
 	if !u.IsAbs() {
@@ -120,12 +180,108 @@ func (p *Profile) Finalize() {
 		}
 	}
 	p.Root.Name = p.r.Method + " " + u.String()
+	if !p.named && p.profiler.RouteName != nil {
+		if name := p.profiler.RouteName(p.r); name != "" {
+			p.Name = name
+		}
+	}
 
-	p.Started = p.start.Unix() * 1000
+	p.Started = p.start.UnixNano() / int64(time.Millisecond)
 	p.DurationMilliseconds = Since(p.start)
 	p.Root.DurationMilliseconds = p.DurationMilliseconds
 
-	Store(p.r, p)
+	// If nothing was written through ResponseWriter and an extension has not
+	// recorded the response itself, the handler wrote nothing and net/http
+	// sends 200 OK.
+	if p.rw.status != 0 || p.StatusCode == 0 {
+		p.rw.finish()
+		p.StatusCode = p.rw.status
+		p.ResponseSize = p.rw.size
+		p.ResponseHeaders = p.rw.header
+		p.ContentType = p.rw.header.Get("Content-Type")
+	}
+
+	if p.profiler.Baselines != nil {
+		p.Regressions = p.profiler.Baselines.Check(p)
+	}
+
+	for _, f := range p.profiler.OnFinalize {
+		f(p.r, p)
+	}
+
+	if !p.profiler.Keep(p) {
+		return
+	}
+	if p.profiler.Stats != nil {
+		p.profiler.Stats.Add(p)
+	}
+	p.profiler.Store(p.r, p)
+}
+
+// Finalized returns true once Finalize has been called on p. Timings added to
+// a finalized profile may not be stored or may race with its readers, so
+// extensions that record steps after they happen, such as from tracing
+// spans, check it first.
+func (p *Profile) Finalized() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.finalized
+}
+
+// Finalized returns true once Finalize has been called on the profile of t.
+// For use only by miniprofiler extensions.
+func (t *Timing) Finalized() bool {
+	return t != nil && t.profile.Finalized()
+}
+
+// Start returns the time the profile started. Timing and CustomTiming
+// StartMilliseconds are relative to it.
+func (p *Profile) Start() time.Time {
+	if p.start.IsZero() {
+		return time.Unix(0, p.Started*int64(time.Millisecond))
+	}
+	return p.start
+}
+
+// CustomTimingCount returns the number of custom timings of callType, such as
+// "sql", recorded anywhere in the profile.
+func (p *Profile) CustomTimingCount(callType string) int {
+	n := 0
+	p.Walk(func(t *Timing) {
+		n += len(t.CustomTimings[callType])
+	})
+	return n
+}
+
+// CustomTimingMilliseconds returns the total duration of the custom timings of
+// callType recorded anywhere in the profile.
+func (p *Profile) CustomTimingMilliseconds(callType string) float64 {
+	var d float64
+	p.Walk(func(t *Timing) {
+		for _, c := range t.CustomTimings[callType] {
+			d += c.DurationMilliseconds
+		}
+	})
+	return d
+}
+
+// Walk calls f for every Timing in p, parents before children. Each Timing is
+// locked while f is called with it, so f may read its fields but must not call
+// methods of the Timing or its Profile that lock it, such as Step.
+func (p *Profile) Walk(f func(*Timing)) {
+	var walk func(t *Timing)
+	walk = func(t *Timing) {
+		t.Lock()
+		children := t.Children
+		f(t)
+		t.Unlock()
+		for _, c := range children {
+			walk(c)
+		}
+	}
+	if p.Root != nil {
+		walk(p.Root)
+	}
 }
 
 // ProfileFromJson returns a Profile from JSON data.
@@ -137,7 +293,9 @@ func ProfileFromJson(b []byte) *Profile {
 
 // Json converts a profile to JSON.
 func (p *Profile) Json() []byte {
+	p.mu.Lock()
 	b, _ := json.Marshal(p)
+	p.mu.Unlock()
 	return b
 }
 
@@ -150,9 +308,18 @@ type Timer interface {
 	Includes() template.HTML
 }
 
+// Tagger is implemented by the Timers of this package, which attach tags and
+// metadata to their profile. It is separate from Timer so that other Timer
+// implementations need not provide it; check for it with a type assertion.
+type Tagger interface {
+	AddTag(key, value string)
+	SetMetadata(key string, value interface{})
+}
+
 func (p *Profile) SetName(name string) {
 	if p.Root != nil {
 		p.Name = name
+		p.named = true
 	}
 }
 
@@ -174,6 +341,45 @@ func (T *Timing) AddCustomLink(name, URL string) {
 	}
 }
 
+// AddTag tags the profile with key and value, such as "tenant" and "acme".
+func (p *Profile) AddTag(key, value string) {
+	if p.Root == nil {
+		return
+	}
+	p.mu.Lock()
+	if p.Tags == nil {
+		p.Tags = make(map[string]string)
+	}
+	p.Tags[key] = value
+	p.mu.Unlock()
+}
+
+func (T *Timing) AddTag(key, value string) {
+	if T != nil {
+		T.profile.AddTag(key, value)
+	}
+}
+
+// SetMetadata attaches value to the profile under key. value is stored as
+// JSON, so it must be encodable by encoding/json.
+func (p *Profile) SetMetadata(key string, value interface{}) {
+	if p.Root == nil {
+		return
+	}
+	p.mu.Lock()
+	if p.Metadata == nil {
+		p.Metadata = make(map[string]interface{})
+	}
+	p.Metadata[key] = value
+	p.mu.Unlock()
+}
+
+func (T *Timing) SetMetadata(key string, value interface{}) {
+	if T != nil {
+		T.profile.SetMetadata(key, value)
+	}
+}
+
 func (p *Profile) AddCustomTiming(callType, executeType string, start, end time.Time, command string) {
 	if p.Root != nil {
 		p.Root.AddCustomTiming(callType, executeType, start, end, command)
@@ -204,15 +410,49 @@ func (T *Timing) Includes() template.HTML {
 }
 
 func (T *Timing) Step(name string, f func(t Timer)) {
+	t := T.BeginStep(name)
+	f(t)
+	t.EndStep()
+}
+
+// BeginStep starts a child step of T, which lasts until EndStep is called on
+// the returned Timing. Unlike Step, it does not need the step's work in a
+// single function, so it suits callbacks such as tracing span processors.
+// For use only by miniprofiler extensions.
+func (T *Timing) BeginStep(name string) *Timing {
+	return T.BeginStepAt(name, time.Now())
+}
+
+// BeginStepAt is like BeginStep, but the step starts at start rather than
+// now, for steps recorded after they began, such as tracing spans.
+// For use only by miniprofiler extensions.
+func (T *Timing) BeginStepAt(name string, start time.Time) *Timing {
+	if T == nil {
+		return nil
+	}
 	t := &Timing{
 		Id:                newGuid(),
 		Name:              name,
-		StartMilliseconds: Since(T.profile.start),
+		StartMilliseconds: start.Sub(T.profile.start).Seconds() * 1000,
 		profile:           T.profile,
 	}
 	T.addChild(t)
-	f(t)
-	t.DurationMilliseconds = Since(t.profile.start) - t.StartMilliseconds
+	return t
+}
+
+// EndStep ends a step started with BeginStep.
+// For use only by miniprofiler extensions.
+func (t *Timing) EndStep() {
+	t.EndStepAt(time.Now())
+}
+
+// EndStepAt is like EndStep, but the step ends at end rather than now.
+// For use only by miniprofiler extensions.
+func (t *Timing) EndStepAt(end time.Time) {
+	if t == nil {
+		return
+	}
+	t.DurationMilliseconds = end.Sub(t.profile.start).Seconds()*1000 - t.StartMilliseconds
 }
 
 func (T *Timing) addChild(t *Timing) {
@@ -289,6 +529,15 @@ type CustomTiming struct {
 type ClientTimings struct {
 	RedirectCount int64
 	Timings       []*ClientTiming
+
+	// Navigation, the paint times and Resources are posted by browsers that
+	// support the Navigation Timing Level 2, Paint Timing, Largest
+	// Contentful Paint and Resource Timing APIs. Times are in milliseconds
+	// since the start of navigation.
+	Navigation             *NavigationTiming `json:",omitempty"`
+	FirstContentfulPaint   float64           `json:",omitempty"`
+	LargestContentfulPaint float64           `json:",omitempty"`
+	Resources              []*ResourceTiming `json:",omitempty"`
 }
 
 func (c *ClientTimings) Len() int           { return len(c.Timings) }
@@ -35,6 +35,9 @@ Set the miniprofiler template data in your controller:
 
 Add {{.miniprofiler}} to your template right before </body>.
 
+Profiles are named by the pattern of the route that matched, such as
+"/users/:id".
+
 For Step and CustomTimer functionality, in your controllers, import:
 
 	"github.com/MiniProfiler/go/miniprofiler"
@@ -24,15 +24,16 @@ import (
 )
 
 func BeforeRouter(c *context.Context) {
-	ok := miniprofiler.Enable(c.Request)
-	if ok && strings.HasPrefix(c.Request.URL.Path, miniprofiler.PATH) {
+	if strings.HasPrefix(c.Request.URL.Path, miniprofiler.DefaultProfiler.Path) {
 		miniprofiler.MiniProfilerHandler(c.ResponseWriter, c.Request)
 		return
 	}
-	p := miniprofiler.NewProfile(c.ResponseWriter, c.Request, c.Request.URL.Path)
+	// The profile is named by the route pattern in AfterExec.
+	p := miniprofiler.NewProfile(c.ResponseWriter.ResponseWriter, c.Request, "")
+	c.ResponseWriter.ResponseWriter = p.ResponseWriter()
 	c.Input.Data["__miniprofiler"] = p
-	if ok {
-		c.Input.Data["miniprofiler"] = p.Includes()
+	if includes := p.Includes(); includes != "" {
+		c.Input.Data["miniprofiler"] = includes
 	}
 }
 
@@ -43,6 +44,9 @@ func AfterExec(c *context.Context) {
 	}
 	p, ok := d.(*miniprofiler.Profile)
 	if ok {
+		if pattern, _ := c.Input.Data["RouterPattern"].(string); pattern != "" {
+			p.SetName(pattern)
+		}
 		p.Finalize()
 	}
 }
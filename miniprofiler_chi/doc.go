@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+/*
+Package miniprofiler_chi provides mini-profiler support for chi routers.
+
+To use this package, import:
+
+	import mpc "github.com/MiniProfiler/go/miniprofiler_chi"
+
+Add the middleware:
+
+	r := chi.NewRouter()
+	r.Use(mpc.Middleware)
+
+It serves the profiler's resources and names profiles by the chi route pattern
+that matched, such as "/users/{id}", so requests to the same route are grouped
+together. Use NewMiddleware to profile with a Profiler other than
+miniprofiler.DefaultProfiler.
+
+The Timer is available from the request's context. Call p.Includes() like
+normal and add it to your template.
+
+Example
+
+	func main() {
+		r := chi.NewRouter()
+		r.Use(mpc.Middleware)
+		r.Get("/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+			p := miniprofiler.GetTimer(r)
+			fmt.Fprintf(w, "<html><body>%v</body></html>", p.Includes())
+		})
+		http.ListenAndServe(":8080", r)
+	}
+
+Profiles are only named by route when created by the middleware. chi records
+the route on the request it passes to the router's handlers, so RouteName
+does not work as miniprofiler.Profiler.RouteName for profiles created by a
+handler wrapping the router, such as one from NewContextHandler; use the
+middleware instead.
+
+See the miniprofiler package docs about further usage: http://godoc.org/github.com/MiniProfiler/go/miniprofiler.
+*/
+package miniprofiler_chi
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+/*
+Package miniprofiler_echo is a simple but effective mini-profiler for echo.
+
+To use this package, import:
+
+	import mpe "github.com/MiniProfiler/go/miniprofiler_echo"
+
+Add the middleware:
+
+	e := echo.New()
+	e.Use(mpe.Middleware())
+
+It serves the profiler's resources and names profiles by the route that
+matched, such as "/users/:id". Use NewMiddleware to profile with a Profiler
+other than miniprofiler.DefaultProfiler.
+
+The Timer is available from the echo context with c.Get("miniprofiler") or
+Timer, and from the request's context with miniprofiler.GetTimer. Templates
+only see the data passed to c.Render, so add Includes to it:
+
+	c.Render(http.StatusOK, "index.html", map[string]interface{}{
+		"miniprofiler": mpe.Includes(c),
+	})
+
+Errors returned by handlers are passed to c.Error before the profile is
+finalized, so their status code is recorded.
+
+Example
+
+	func main() {
+		e := echo.New()
+		e.Use(mpe.Middleware())
+		e.GET("/users/:id", func(c echo.Context) error {
+			mpe.Timer(c).Step("load", func(t miniprofiler.Timer) {
+				// do some work
+			})
+			return c.HTML(http.StatusOK, fmt.Sprintf("<html><body>%v</body></html>", mpe.Includes(c)))
+		})
+		e.Start(":8080")
+	}
+
+See the miniprofiler package docs about further usage: http://godoc.org/github.com/MiniProfiler/go/miniprofiler.
+*/
+package miniprofiler_echo
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofiler_echo
+
+import (
+	"html/template"
+	"strings"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/labstack/echo/v4"
+)
+
+// Middleware returns echo middleware that profiles requests using
+// miniprofiler.DefaultProfiler.
+func Middleware() echo.MiddlewareFunc {
+	return NewMiddleware(miniprofiler.DefaultProfiler)
+}
+
+// NewMiddleware returns echo middleware that profiles requests using mp.
+func NewMiddleware(mp *miniprofiler.Profiler) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) (err error) {
+			r := c.Request()
+			if strings.HasPrefix(r.URL.Path, mp.Path) {
+				mp.ServeHTTP(c.Response(), r)
+				return nil
+			}
+			name := c.Path()
+			if name == "" {
+				name = r.URL.Path
+			}
+			res := c.Response()
+			p := mp.NewProfile(res.Writer, r, name)
+			res.Writer = p.ResponseWriter()
+			c.SetRequest(p.WithRequest(r))
+			c.Set("miniprofiler", miniprofiler.Timer(p))
+			if includes := p.Includes(); includes != "" {
+				c.Set("miniprofiler_includes", includes)
+			}
+			if err = next(c); err != nil {
+				c.Error(err)
+			}
+			p.Finalize()
+			return
+		}
+	}
+}
+
+// Timer returns the Timer of c's request, or nil if the middleware did not
+// run.
+func Timer(c echo.Context) miniprofiler.Timer {
+	t, _ := c.Get("miniprofiler").(miniprofiler.Timer)
+	return t
+}
+
+// Includes returns the Includes of c's request, for template data. It is
+// empty if the request is not profiled.
+func Includes(c echo.Context) template.HTML {
+	includes, _ := c.Get("miniprofiler_includes").(template.HTML)
+	return includes
+}
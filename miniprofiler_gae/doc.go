@@ -30,7 +30,7 @@ Send output of c.Includes() to your HTML (it is empty if Enable returns
 false).
 
 By default, miniprofiler_gae is enabled on dev for all and on prod for admins.
-Override miniprofiler.Enable to change.
+Override miniprofiler.DefaultProfiler.Enable and Authorize to change.
 
 Step
 
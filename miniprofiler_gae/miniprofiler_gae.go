@@ -29,14 +29,17 @@ import (
 )
 
 func init() {
-	miniprofiler.Enable = EnableIfAdminOrDev
-	miniprofiler.Get = GetMemcache
-	miniprofiler.Store = StoreMemcache
-	miniprofiler.MachineName = Instance
+	miniprofiler.DefaultProfiler.Enable = EnableIfAdminOrDev
+	miniprofiler.DefaultProfiler.Authorize = EnableIfAdminOrDev
+	miniprofiler.DefaultProfiler.Get = GetMemcache
+	miniprofiler.DefaultProfiler.Store = StoreMemcache
+	miniprofiler.DefaultProfiler.MachineName = Instance
+	miniprofiler.DefaultProfiler.List = nil
 }
 
 // EnableIfAdminOrDev returns true if this is the dev server or the current
-// user is an admin. This is the default for miniprofiler.Enable.
+// user is an admin. This is the default for miniprofiler.DefaultProfiler.Enable
+// and Authorize.
 func EnableIfAdminOrDev(r *http.Request) bool {
 	if appengine.IsDevAppServer() {
 		return true
@@ -49,7 +52,7 @@ func EnableIfAdminOrDev(r *http.Request) bool {
 }
 
 // Instance returns the app engine instance id, or the hostname on dev.
-// This is the default for miniprofiler.MachineName.
+// This is the default for miniprofiler.DefaultProfiler.MachineName.
 func Instance() string {
 	if i := appengine.InstanceID(); i != "" && !appengine.IsDevAppServer() {
 		return i[len(i)-8:]
@@ -58,7 +61,7 @@ func Instance() string {
 }
 
 // StoreMemcache stores the Profile in memcache. This is the default for
-// miniprofiler.Store.
+// miniprofiler.DefaultProfiler.Store.
 func StoreMemcache(r *http.Request, p *miniprofiler.Profile) {
 	item := &memcache.Item{
 		Key:   mp_key(string(p.Id)),
@@ -69,7 +72,7 @@ func StoreMemcache(r *http.Request, p *miniprofiler.Profile) {
 }
 
 // GetMemcache gets the Profile from memcache. This is the default for
-// miniprofiler.Get.
+// miniprofiler.DefaultProfiler.Get.
 func GetMemcache(r *http.Request, id string) *miniprofiler.Profile {
 	c := appengine.NewContext(r)
 	item, err := memcache.Get(c, mp_key(id))
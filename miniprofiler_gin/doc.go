@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+/*
+Package miniprofiler_gin is a simple but effective mini-profiler for gin.
+
+To use this package, import:
+
+	import mpg "github.com/MiniProfiler/go/miniprofiler_gin"
+
+Add the middleware:
+
+	r := gin.Default()
+	r.Use(mpg.Middleware())
+
+It serves the profiler's resources and names profiles by the route that
+matched, such as "/users/:id". Use NewMiddleware to profile with a Profiler
+other than miniprofiler.DefaultProfiler.
+
+The Timer is available from the gin context with c.Get("miniprofiler") or
+Timer, and from the request's context with miniprofiler.GetTimer. gin
+templates only see the data passed to c.HTML, so add Includes to it:
+
+	c.HTML(http.StatusOK, "index.tmpl", gin.H{
+		"miniprofiler": mpg.Includes(c),
+	})
+
+The middleware replaces c.Writer for the rest of the chain with one that
+writes through the profile's ResponseWriter, so the response status code,
+size and headers are recorded, and AutoIncludes and ServerTiming apply to gin
+responses too.
+
+Example
+
+	func main() {
+		r := gin.Default()
+		r.Use(mpg.Middleware())
+		r.GET("/users/:id", func(c *gin.Context) {
+			mpg.Timer(c).Step("load", func(t miniprofiler.Timer) {
+				// do some work
+			})
+			c.Header("Content-Type", "text/html")
+			c.String(http.StatusOK, "<html><body>%v</body></html>", mpg.Includes(c))
+		})
+		r.Run(":8080")
+	}
+
+See the miniprofiler package docs about further usage: http://godoc.org/github.com/MiniProfiler/go/miniprofiler.
+*/
+package miniprofiler_gin
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofiler_gin
+
+import (
+	"bufio"
+	"html/template"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/gin-gonic/gin"
+)
+
+// Middleware returns gin middleware that profiles requests using
+// miniprofiler.DefaultProfiler.
+func Middleware() gin.HandlerFunc {
+	return NewMiddleware(miniprofiler.DefaultProfiler)
+}
+
+// NewMiddleware returns gin middleware that profiles requests using mp.
+func NewMiddleware(mp *miniprofiler.Profiler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if strings.HasPrefix(c.Request.URL.Path, mp.Path) {
+			mp.ServeHTTP(c.Writer, c.Request)
+			c.Abort()
+			return
+		}
+		name := c.FullPath()
+		if name == "" {
+			name = c.Request.URL.Path
+		}
+		p := mp.NewProfile(c.Writer, c.Request, name)
+		c.Request = p.WithRequest(c.Request)
+		c.Set("miniprofiler", miniprofiler.Timer(p))
+		if includes := p.Includes(); includes != "" {
+			c.Set("miniprofiler_includes", includes)
+		}
+		gw := c.Writer
+		w := &responseWriter{ResponseWriter: gw, w: p.ResponseWriter()}
+		c.Writer = w
+		c.Next()
+		// gin writes the header of responses without a body after the
+		// handlers return, which is too late for the profile.
+		w.WriteHeaderNow()
+		p.Finalize()
+		c.Writer = gw
+	}
+}
+
+// responseWriter is a gin.ResponseWriter that writes the response through
+// the profile's ResponseWriter, so it is recorded and AutoIncludes and
+// ServerTiming apply. gin's writer only sees the response once the profile's
+// writer sends it, so the status is tracked here too.
+type responseWriter struct {
+	gin.ResponseWriter
+	w       http.ResponseWriter
+	status  int
+	written bool
+}
+
+func (w *responseWriter) WriteHeader(code int) {
+	if code > 0 && !w.written {
+		w.status = code
+	}
+}
+
+func (w *responseWriter) WriteHeaderNow() {
+	if !w.written {
+		w.written = true
+		w.w.WriteHeader(w.Status())
+	}
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	w.WriteHeaderNow()
+	return w.w.Write(b)
+}
+
+func (w *responseWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *responseWriter) Status() int {
+	if w.status != 0 {
+		return w.status
+	}
+	return w.ResponseWriter.Status()
+}
+
+func (w *responseWriter) Written() bool {
+	return w.written
+}
+
+func (w *responseWriter) Flush() {
+	w.WriteHeaderNow()
+	if f, ok := w.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.written = true
+	if h, ok := w.w.(http.Hijacker); ok {
+		return h.Hijack()
+	}
+	return w.ResponseWriter.Hijack()
+}
+
+// Timer returns the Timer of c's request, or nil if the middleware did not
+// run.
+func Timer(c *gin.Context) miniprofiler.Timer {
+	v, _ := c.Get("miniprofiler")
+	t, _ := v.(miniprofiler.Timer)
+	return t
+}
+
+// Includes returns the Includes of c's request, for template data. It is
+// empty if the request is not profiled.
+func Includes(c *gin.Context) template.HTML {
+	v, _ := c.Get("miniprofiler_includes")
+	includes, _ := v.(template.HTML)
+	return includes
+}
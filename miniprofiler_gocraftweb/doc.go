@@ -40,6 +40,11 @@ Add c.MiniProfilerTemplate right before </body>.
 
 Use c.MiniProfilerTimer as a miniprofiler.Timer.
 
+Profiles are named by the path of the route that matched. gocraft/web passes
+its own ResponseWriter down the middleware chain, so the response status code,
+size and content type are taken from it, and AutoIncludes and ServerTiming
+have no effect.
+
 Example
 
 A full example application:
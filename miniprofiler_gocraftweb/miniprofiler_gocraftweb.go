@@ -30,16 +30,21 @@ type MiniProfilerContext struct {
 }
 
 func (c *MiniProfilerContext) MiniProfileMiddleware(rw web.ResponseWriter, r *web.Request, next web.NextMiddlewareFunc) {
-	ok := miniprofiler.Enable(r.Request)
-	if ok && strings.HasPrefix(r.Request.URL.Path, miniprofiler.PATH) {
+	if strings.HasPrefix(r.Request.URL.Path, miniprofiler.DefaultProfiler.Path) {
 		miniprofiler.MiniProfilerHandler(rw, r.Request)
 		return
 	}
-	p := miniprofiler.NewProfile(rw, r.Request, r.URL.Path)
+	p := miniprofiler.NewProfile(rw, r.Request, "")
 	c.MiniProfilerTemplate = p.Includes()
 	c.MiniProfilerTimer = p
 	next(rw, r)
-	if ok {
-		p.Finalize()
+	if route := r.RoutePath(); route != "" {
+		p.SetName(route)
 	}
+	// gocraft/web passes its own ResponseWriter down the chain, so record
+	// the response from it.
+	p.StatusCode = rw.StatusCode()
+	p.ResponseSize = int64(rw.Size())
+	p.ContentType = rw.Header().Get("Content-Type")
+	p.Finalize()
 }
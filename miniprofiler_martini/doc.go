@@ -26,7 +26,8 @@ Add the middleware:
 	m.Use(mmp.Profiler())
 
 Now a mmp.Timer object is available via the injector. Call p.Includes() like
-normal and add it to your template.
+normal and add it to your template. Profiles are named by the pattern of the
+route that matched, such as "/users/:id".
 
 Example
 
@@ -2,6 +2,7 @@ package miniprofiler_martini
 
 import (
 	"net/http"
+	"reflect"
 	"strings"
 
 	"github.com/MiniProfiler/go/miniprofiler"
@@ -14,13 +15,18 @@ type Timer interface {
 
 func Profiler() martini.Handler {
 	return func(c martini.Context, w http.ResponseWriter, r *http.Request) {
-		if strings.HasPrefix(r.URL.Path, miniprofiler.PATH) {
+		if strings.HasPrefix(r.URL.Path, miniprofiler.DefaultProfiler.Path) {
 			miniprofiler.MiniProfilerHandler(w, r)
 			return
 		}
-		p := miniprofiler.NewProfile(w, r, r.URL.Path)
+		p := miniprofiler.NewProfile(w, r, "")
 		c.MapTo(p, (*Timer)(nil))
+		c.MapTo(p.ResponseWriter(), (*http.ResponseWriter)(nil))
 		c.Next()
+		// The router maps the Route that matched into the context.
+		if v := c.Get(reflect.TypeOf((*martini.Route)(nil)).Elem()); v.IsValid() {
+			p.SetName(v.Interface().(martini.Route).Pattern())
+		}
 		p.Finalize()
 	}
 }
@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+/*
+Package miniprofiler_mux provides mini-profiler support for gorilla/mux routers.
+
+To use this package, import:
+
+	import mpm "github.com/MiniProfiler/go/miniprofiler_mux"
+
+Add the middleware, and a route for the profiler's resources:
+
+	r := mux.NewRouter()
+	r.Use(mpm.Middleware)
+	r.PathPrefix(miniprofiler.DefaultProfiler.Path).Handler(miniprofiler.DefaultProfiler)
+
+gorilla/mux only runs middleware for requests that match a route, hence the
+second route. Profiles are named by the path template of the route that
+matched, such as "/users/{id}", so requests to the same route are grouped
+together. Use NewMiddleware to profile with a Profiler other than
+miniprofiler.DefaultProfiler.
+
+The Timer is available from the request's context. Call p.Includes() like
+normal and add it to your template.
+
+Example
+
+	func main() {
+		r := mux.NewRouter()
+		r.Use(mpm.Middleware)
+		r.PathPrefix(miniprofiler.DefaultProfiler.Path).Handler(miniprofiler.DefaultProfiler)
+		r.HandleFunc("/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+			p := miniprofiler.GetTimer(r)
+			fmt.Fprintf(w, "<html><body>%v</body></html>", p.Includes())
+		})
+		http.ListenAndServe(":8080", r)
+	}
+
+Profiles are only named by route when created by the middleware. gorilla/mux
+records the matched route on the request it passes to the route's handler, so
+RouteName does not work as miniprofiler.Profiler.RouteName for profiles
+created by a handler wrapping the router, such as one from
+NewContextHandler; use the middleware instead.
+
+See the miniprofiler package docs about further usage: http://godoc.org/github.com/MiniProfiler/go/miniprofiler.
+*/
+package miniprofiler_mux
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofiler_mux
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/gorilla/mux"
+)
+
+// Middleware profiles requests using miniprofiler.DefaultProfiler. Add it to
+// a router with Router.Use.
+func Middleware(next http.Handler) http.Handler {
+	return NewMiddleware(miniprofiler.DefaultProfiler)(next)
+}
+
+// NewMiddleware returns a mux.MiddlewareFunc that profiles requests using mp.
+// It serves mp's resources under mp.Path, passes the Timer on the request's
+// context, and names profiles by route path template.
+func NewMiddleware(mp *miniprofiler.Profiler) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if strings.HasPrefix(r.URL.Path, mp.Path) {
+				mp.ServeHTTP(w, r)
+				return
+			}
+			name := r.URL.Path
+			if route := RouteName(r); route != "" {
+				name = route
+			}
+			p := mp.NewProfile(w, r, name)
+			next.ServeHTTP(p.ResponseWriter(), p.WithRequest(r))
+			p.Finalize()
+		})
+	}
+}
+
+// RouteName returns the path template of the gorilla/mux route that matched
+// r, or "" if there is none. r must be a request passed to a handler by the
+// router, so as miniprofiler.Profiler.RouteName it only names profiles created
+// inside the router.
+func RouteName(r *http.Request) string {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return ""
+	}
+	tmpl, err := route.GetPathTemplate()
+	if err != nil {
+		return ""
+	}
+	return tmpl
+}
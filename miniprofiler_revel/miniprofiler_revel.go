@@ -24,19 +24,17 @@ import (
 )
 
 func Filter(c *revel.Controller, fc []revel.Filter) {
-	ok := miniprofiler.Enable(c.Request.Request)
-	if ok && strings.HasPrefix(c.Request.Request.URL.Path, miniprofiler.PATH) {
+	if strings.HasPrefix(c.Request.Request.URL.Path, miniprofiler.DefaultProfiler.Path) {
 		miniprofiler.MiniProfilerHandler(c.Response.Out, c.Request.Request)
 		return
 	}
 	p := miniprofiler.NewProfile(c.Response.Out, c.Request.Request, c.Action)
+	c.Response.Out = p.ResponseWriter()
 	c.Args["miniprofiler"] = p
-	if ok {
-		c.RenderArgs["miniprofiler"] = p.Includes()
+	if includes := p.Includes(); includes != "" {
+		c.RenderArgs["miniprofiler"] = includes
 	}
 	fc[0](c, fc[1:])
-	if ok {
-		p.SetName(c.Action)
-		p.Finalize()
-	}
+	p.SetName(c.Action)
+	p.Finalize()
 }
@@ -39,6 +39,11 @@ and add to the top of every handler:
 
 Now t is available as a normal miniprofiler.Timer.
 
+traffic does not expose the route that matched, so profiles are unnamed
+unless Profiler.RouteName names them. traffic passes its own ResponseWriter
+down the middleware chain, so the response status code, size and content type
+are taken from it, and AutoIncludes and ServerTiming have no effect.
+
 See the miniprofiler package docs about further usage: http://godoc.org/github.com/MiniProfiler/go/miniprofiler.
 
 Example handler
@@ -26,19 +26,23 @@ import (
 type Middleware struct{}
 
 func (c *Middleware) ServeHTTP(w traffic.ResponseWriter, r *traffic.Request, next traffic.NextMiddlewareFunc) {
-	ok := miniprofiler.Enable(r.Request)
-	if ok && strings.HasPrefix(r.Request.URL.Path, miniprofiler.PATH) {
+	if strings.HasPrefix(r.Request.URL.Path, miniprofiler.DefaultProfiler.Path) {
 		miniprofiler.MiniProfilerHandler(w, r.Request)
 		return
 	}
-	p := miniprofiler.NewProfile(w, r.Request, r.URL.Path)
+	// traffic does not expose the matched route, so leave the name to
+	// Profiler.RouteName.
+	p := miniprofiler.NewProfile(w, r.Request, "")
 	w.SetVar("miniprofiler", p.Includes())
 	w.SetVar("miniprofiler_timer", p)
 	if nextMiddleware := next(); nextMiddleware != nil {
 		nextMiddleware.ServeHTTP(w, r, next)
 	}
-	if ok {
-		p.Finalize()
-	}
+	// traffic passes its own ResponseWriter down the chain, so record the
+	// response from it.
+	p.StatusCode = w.StatusCode()
+	p.ResponseSize = int64(w.Size())
+	p.ContentType = w.Header().Get("Content-Type")
+	p.Finalize()
 	return
 }
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+/*
+Package miniprofilertest provides utilities for testing code profiled with
+miniprofiler, such as to lock in the number of queries a handler makes.
+
+To use this package, import:
+
+	import "github.com/MiniProfiler/go/miniprofilertest"
+
+Serve runs a request through a handler wrapped with NewContextHandler and
+returns its Profile along with the recorded response; ServeHandler does the
+same for a NewHandler function. The Require functions then fail the test if
+the profile exceeds its budget.
+
+For tests that drive a server of their own, NewProfiler returns a Profiler
+that profiles every request and records the profiles in a Store.
+
+Example
+
+	func TestUser(t *testing.T) {
+		p, w := miniprofilertest.Serve(t, userHandler, httptest.NewRequest("GET", "/users/1", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("status %d", w.Code)
+		}
+		miniprofilertest.RequireMaxQueries(t, p, "sql", 5)
+		miniprofilertest.RequireNoDuplicateQueries(t, p, "sql")
+		miniprofilertest.RequireStepUnder(t, p, "render", 50*time.Millisecond)
+	}
+*/
+package miniprofilertest
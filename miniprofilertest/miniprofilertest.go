@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+package miniprofilertest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+)
+
+// Store records stored profiles in order. Its methods are suitable for
+// Profiler.Store, Get and List.
+type Store struct {
+	sync.Mutex
+	profiles []*miniprofiler.Profile
+}
+
+// NewStore returns an empty Store.
+func NewStore() *Store {
+	return new(Store)
+}
+
+// Store records p.
+func (s *Store) Store(r *http.Request, p *miniprofiler.Profile) {
+	s.Lock()
+	s.profiles = append(s.profiles, p)
+	s.Unlock()
+}
+
+// Get returns the recorded profile with id, or nil.
+func (s *Store) Get(r *http.Request, id string) *miniprofiler.Profile {
+	s.Lock()
+	defer s.Unlock()
+	for _, p := range s.profiles {
+		if p.Id == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// List returns up to n of the recorded profiles, newest first.
+func (s *Store) List(r *http.Request, n int) []*miniprofiler.Profile {
+	s.Lock()
+	defer s.Unlock()
+	var l []*miniprofiler.Profile
+	for i := len(s.profiles) - 1; i >= 0 && len(l) < n; i-- {
+		l = append(l, s.profiles[i])
+	}
+	return l
+}
+
+// Profiles returns the recorded profiles, oldest first.
+func (s *Store) Profiles() []*miniprofiler.Profile {
+	s.Lock()
+	defer s.Unlock()
+	return append([]*miniprofiler.Profile(nil), s.profiles...)
+}
+
+// Last returns the most recently recorded profile, or nil if there are none.
+func (s *Store) Last() *miniprofiler.Profile {
+	s.Lock()
+	defer s.Unlock()
+	if len(s.profiles) == 0 {
+		return nil
+	}
+	return s.profiles[len(s.profiles)-1]
+}
+
+// Reset discards the recorded profiles.
+func (s *Store) Reset() {
+	s.Lock()
+	s.profiles = nil
+	s.Unlock()
+}
+
+// NewProfiler returns a Profiler with the default configuration that stores
+// its profiles in s.
+func NewProfiler(s *Store) *miniprofiler.Profiler {
+	mp := miniprofiler.New()
+	mp.Store = s.Store
+	mp.Get = s.Get
+	mp.List = s.List
+	return mp
+}
+
+// Serve serves r with h wrapped by NewContextHandler of a new Profiler, and
+// returns the request's Profile and the recorded response.
+func Serve(t testing.TB, h http.Handler, r *http.Request) (*miniprofiler.Profile, *httptest.ResponseRecorder) {
+	t.Helper()
+	s := NewStore()
+	return serve(t, s, NewProfiler(s).NewContextHandler(h), r)
+}
+
+// ServeHandler serves r with f wrapped by NewHandler of a new Profiler, and
+// returns the request's Profile and the recorded response.
+func ServeHandler(t testing.TB, f func(miniprofiler.Timer, http.ResponseWriter, *http.Request), r *http.Request) (*miniprofiler.Profile, *httptest.ResponseRecorder) {
+	t.Helper()
+	s := NewStore()
+	return serve(t, s, NewProfiler(s).NewHandler(f), r)
+}
+
+func serve(t testing.TB, s *Store, h http.Handler, r *http.Request) (*miniprofiler.Profile, *httptest.ResponseRecorder) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	p := s.Last()
+	if p == nil {
+		t.Fatalf("miniprofilertest: %s %s was not profiled", r.Method, r.URL)
+	}
+	return p, w
+}
+
+// RequireMaxQueries fails the test if p has more than n custom timings of
+// callType, such as "sql".
+func RequireMaxQueries(t testing.TB, p *miniprofiler.Profile, callType string, n int) {
+	t.Helper()
+	cts := customTimings(p, callType)
+	if len(cts) <= n {
+		return
+	}
+	t.Errorf("%s: %d %s queries, want at most %d:", p.Name, len(cts), callType, n)
+	for _, ct := range cts {
+		t.Errorf("\t%s", ct.CommandString)
+	}
+	t.FailNow()
+}
+
+// RequireNoDuplicateQueries fails the test if p has more than one custom
+// timing of callType with the same command.
+func RequireNoDuplicateQueries(t testing.TB, p *miniprofiler.Profile, callType string) {
+	t.Helper()
+	counts := make(map[string]int)
+	for _, ct := range customTimings(p, callType) {
+		counts[ct.CommandString]++
+	}
+	var dups []string
+	for command, n := range counts {
+		if n > 1 {
+			dups = append(dups, command)
+		}
+	}
+	if len(dups) == 0 {
+		return
+	}
+	sort.Strings(dups)
+	t.Errorf("%s: duplicate %s queries:", p.Name, callType)
+	for _, command := range dups {
+		t.Errorf("\t%dx %s", counts[command], command)
+	}
+	t.FailNow()
+}
+
+// RequireStepUnder fails the test if p has no step named name, or if any
+// step named name took d or longer.
+func RequireStepUnder(t testing.TB, p *miniprofiler.Profile, name string, d time.Duration) {
+	t.Helper()
+	max := float64(d) / float64(time.Millisecond)
+	found := false
+	for _, s := range timings(p) {
+		if s == p.Root || s.Name != name {
+			continue
+		}
+		found = true
+		if s.DurationMilliseconds >= max {
+			t.Fatalf("%s: step %q took %.1fms, want under %v", p.Name, name, s.DurationMilliseconds, d)
+		}
+	}
+	if !found {
+		t.Fatalf("%s: no step %q", p.Name, name)
+	}
+}
+
+// timings returns the Timings of p, parents before children.
+func timings(p *miniprofiler.Profile) []*miniprofiler.Timing {
+	var l []*miniprofiler.Timing
+	p.Walk(func(t *miniprofiler.Timing) {
+		l = append(l, t)
+	})
+	return l
+}
+
+// customTimings returns the custom timings of callType in p, in the order of
+// their Timings.
+func customTimings(p *miniprofiler.Profile, callType string) []*miniprofiler.CustomTiming {
+	var l []*miniprofiler.CustomTiming
+	p.Walk(func(t *miniprofiler.Timing) {
+		l = append(l, t.CustomTimings[callType]...)
+	})
+	return l
+}
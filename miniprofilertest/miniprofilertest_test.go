@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package miniprofilertest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+)
+
+// fakeT is a testing.TB that records failures instead of reporting them.
+type fakeT struct {
+	testing.TB
+	failed bool
+	fatal  bool
+	logs   []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.failed = true
+	t.logs = append(t.logs, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) Fatalf(format string, args ...interface{}) {
+	t.Errorf(format, args...)
+	t.FailNow()
+}
+
+func (t *fakeT) FailNow() {
+	t.failed = true
+	t.fatal = true
+	runtime.Goexit()
+}
+
+// run calls f with a new fakeT in its own goroutine, so FailNow can stop it
+// as it stops a test.
+func run(f func(t testing.TB)) *fakeT {
+	t := new(fakeT)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f(t)
+	}()
+	<-done
+	return t
+}
+
+// check fails the test unless ft failed exactly when fail is true, with a
+// message containing want.
+func check(t *testing.T, ft *fakeT, fail bool, want string) {
+	t.Helper()
+	if ft.failed != fail || ft.fatal != fail {
+		t.Fatalf("failed = %v, fatal = %v, want %v; logs: %q", ft.failed, ft.fatal, fail, ft.logs)
+	}
+	if fail && !strings.Contains(strings.Join(ft.logs, "\n"), want) {
+		t.Fatalf("logs %q don't contain %q", ft.logs, want)
+	}
+}
+
+// queries returns a handler making a sql query for each of commands.
+func queries(commands ...string) func(miniprofiler.Timer, http.ResponseWriter, *http.Request) {
+	return func(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) {
+		now := time.Now()
+		for _, c := range commands {
+			t.AddCustomTiming("sql", "query", now, now, c)
+		}
+		w.Write([]byte("ok"))
+	}
+}
+
+func TestServe(t *testing.T) {
+	var p *miniprofiler.Profile
+	var w *httptest.ResponseRecorder
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		miniprofiler.GetTimer(r).(miniprofiler.Tagger).AddTag("handler", "yes")
+		w.Write([]byte("ok"))
+	})
+	ft := run(func(t testing.TB) {
+		p, w = Serve(t, h, httptest.NewRequest("GET", "/users/1", nil))
+	})
+	check(t, ft, false, "")
+	if p.Tags["handler"] != "yes" {
+		t.Errorf("tags = %v, want handler:yes", p.Tags)
+	}
+	if w.Body.String() != "ok" {
+		t.Errorf("body = %q, want ok", w.Body.String())
+	}
+
+	// Requests for the profiler's resources are not profiled.
+	ft = run(func(t testing.TB) {
+		Serve(t, h, httptest.NewRequest("GET", miniprofiler.PATH+"includes.js", nil))
+	})
+	check(t, ft, true, "was not profiled")
+}
+
+func TestServeHandler(t *testing.T) {
+	var p *miniprofiler.Profile
+	ft := run(func(t testing.TB) {
+		p, _ = ServeHandler(t, queries("select 1"), httptest.NewRequest("GET", "/", nil))
+	})
+	check(t, ft, false, "")
+	if n := p.CustomTimingCount("sql"); n != 1 {
+		t.Errorf("sql count = %d, want 1", n)
+	}
+
+	ft = run(func(t testing.TB) {
+		ServeHandler(t, queries(), httptest.NewRequest("GET", miniprofiler.PATH+"results-index", nil))
+	})
+	check(t, ft, true, "was not profiled")
+}
+
+func TestRequireMaxQueries(t *testing.T) {
+	tests := []struct {
+		commands []string
+		max      int
+		fail     bool
+	}{
+		{nil, 0, false},
+		{[]string{"select 1", "select 2"}, 2, false},
+		{[]string{"select 1", "select 2"}, 1, true},
+	}
+	for _, test := range tests {
+		p, _ := ServeHandler(t, queries(test.commands...), httptest.NewRequest("GET", "/", nil))
+		ft := run(func(t testing.TB) {
+			RequireMaxQueries(t, p, "sql", test.max)
+		})
+		check(t, ft, test.fail, "select 2")
+	}
+}
+
+func TestRequireNoDuplicateQueries(t *testing.T) {
+	tests := []struct {
+		commands []string
+		fail     bool
+	}{
+		{nil, false},
+		{[]string{"select 1", "select 2"}, false},
+		{[]string{"select 1", "select 2", "select 1"}, true},
+	}
+	for _, test := range tests {
+		p, _ := ServeHandler(t, queries(test.commands...), httptest.NewRequest("GET", "/", nil))
+		ft := run(func(t testing.TB) {
+			RequireNoDuplicateQueries(t, p, "sql")
+		})
+		check(t, ft, test.fail, "2x select 1")
+	}
+}
+
+func TestRequireStepUnder(t *testing.T) {
+	h := func(t miniprofiler.Timer, w http.ResponseWriter, r *http.Request) {
+		p := t.(*miniprofiler.Profile)
+		p.Root.BeginStepAt("load", p.Start()).EndStepAt(p.Start().Add(50 * time.Millisecond))
+	}
+	p, _ := ServeHandler(t, h, httptest.NewRequest("GET", "/", nil))
+	tests := []struct {
+		name string
+		d    time.Duration
+		fail bool
+		want string
+	}{
+		{"load", time.Second, false, ""},
+		{"load", 10 * time.Millisecond, true, `step "load" took`},
+		{"save", time.Second, true, `no step "save"`},
+	}
+	for _, test := range tests {
+		ft := run(func(t testing.TB) {
+			RequireStepUnder(t, p, test.name, test.d)
+		})
+		check(t, ft, test.fail, test.want)
+	}
+}
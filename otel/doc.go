@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+/*
+Package otel connects mini-profiler and OpenTelemetry in both directions:
+profiles can be exported as spans, and spans can be shown in profiles.
+
+To use this package, import:
+
+	import mpotel "github.com/MiniProfiler/go/otel"
+
+Add a hook to the profiler, using a tracer from your TracerProvider:
+
+	tracer := otel.Tracer("miniprofiler")
+	miniprofiler.DefaultProfiler.OnFinalize = append(
+		miniprofiler.DefaultProfiler.OnFinalize,
+		mpotel.NewHook(tracer, otel.GetTextMapPropagator()),
+	)
+
+Each finalized profile becomes a server span named after the profile, with a
+child span for each step and a client span for each custom timing (sql,
+redis, ...), all with the start times and durations that were recorded. The
+existing sql and redis wrappers thereby feed the tracing backend without
+further instrumentation.
+
+If a propagator is given, the spans join the trace of the incoming request,
+as described by its headers. Otherwise they join any span in the request's
+context, such as one started by otelhttp, or start a new trace.
+
+Export converts a single profile, and can be used with profiles loaded from a
+store.
+
+Spans in profiles
+
+Many libraries emit OpenTelemetry spans. To show them in the popup, register a
+SpanProcessor with the TracerProvider:
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(mpotel.NewSpanProcessor()),
+	)
+
+Spans started with the context of a profiled request, such as the one from
+miniprofiler.GetTimer's request, and their descendants are mirrored into its
+profile. Client spans become custom timings, typed by their database, RPC or
+messaging system ("http" for HTTP clients) and showing their statement or
+URL. Other spans become steps. Spans must end before the profile is
+finalized, usually at the end of the handler; those that end later are left
+out.
+*/
+package otel
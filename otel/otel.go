@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package otel
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewHook returns a function for miniprofiler.Profiler.OnFinalize that
+// exports each profile with tracer. If propagator is not nil, it extracts the
+// parent trace context from the request's headers; otherwise the request's
+// context is used.
+func NewHook(tracer trace.Tracer, propagator propagation.TextMapPropagator) func(*http.Request, *miniprofiler.Profile) {
+	return func(r *http.Request, p *miniprofiler.Profile) {
+		ctx := r.Context()
+		if propagator != nil {
+			ctx = propagator.Extract(ctx, propagation.HeaderCarrier(r.Header))
+		}
+		Export(ctx, tracer, p)
+	}
+}
+
+// Export records p as spans with tracer. The root span is a child of any span
+// in ctx.
+func Export(ctx context.Context, tracer trace.Tracer, p *miniprofiler.Profile) {
+	if p.Root == nil {
+		return
+	}
+	attrs := []attribute.KeyValue{
+		attribute.String("miniprofiler.id", p.Id),
+		attribute.String("miniprofiler.machine_name", p.MachineName),
+	}
+	if p.StatusCode != 0 {
+		attrs = append(attrs, attribute.Int("http.response.status_code", p.StatusCode))
+	}
+	for k, v := range p.Tags {
+		attrs = append(attrs, attribute.String("miniprofiler.tag."+k, v))
+	}
+	// Hide any Timer in ctx, so a SpanProcessor on the same TracerProvider
+	// does not mirror these spans back into a profile.
+	ctx = miniprofiler.NewContext(ctx, nil)
+	start := p.Start()
+	ctx, span := tracer.Start(ctx, p.Name,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithTimestamp(start),
+		trace.WithAttributes(attrs...),
+	)
+	if p.StatusCode >= 500 {
+		span.SetStatus(codes.Error, http.StatusText(p.StatusCode))
+	}
+	// Each step's span is the parent of the spans of its custom timings
+	// and child steps.
+	ctxs := map[*miniprofiler.Timing]context.Context{p.Root: ctx}
+	p.Walk(func(t *miniprofiler.Timing) {
+		tctx := ctxs[t]
+		for callType, cts := range t.CustomTimings {
+			for _, ct := range cts {
+				_, span := tracer.Start(tctx, callType+" "+ct.ExecuteType,
+					trace.WithSpanKind(trace.SpanKindClient),
+					trace.WithTimestamp(at(start, ct.StartMilliseconds)),
+					trace.WithAttributes(
+						attribute.String("miniprofiler.call_type", callType),
+						attribute.String("miniprofiler.execute_type", ct.ExecuteType),
+						attribute.String("miniprofiler.command", ct.CommandString),
+					),
+				)
+				span.End(trace.WithTimestamp(at(start, ct.StartMilliseconds+ct.DurationMilliseconds)))
+			}
+		}
+		for _, c := range t.Children {
+			cctx, span := tracer.Start(tctx, c.Name,
+				trace.WithSpanKind(trace.SpanKindInternal),
+				trace.WithTimestamp(at(start, c.StartMilliseconds)),
+			)
+			span.End(trace.WithTimestamp(at(start, c.StartMilliseconds+c.DurationMilliseconds)))
+			ctxs[c] = cctx
+		}
+	})
+	span.End(trace.WithTimestamp(at(start, p.Root.DurationMilliseconds)))
+}
+
+// at returns the time ms milliseconds after start.
+func at(start time.Time, ms float64) time.Time {
+	return start.Add(time.Duration(ms * float64(time.Millisecond)))
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package otel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestExport(t *testing.T) {
+	mp := miniprofiler.New()
+	mp.Store = func(*http.Request, *miniprofiler.Profile) {}
+	p := mp.NewProfile(httptest.NewRecorder(), httptest.NewRequest("GET", "/users/1", nil), "/users/{id}")
+	var queryStart, queryEnd time.Time
+	p.Step("render", func(t miniprofiler.Timer) {
+		queryStart = time.Now()
+		queryEnd = queryStart.Add(2 * time.Millisecond)
+		t.AddCustomTiming("sql", "query", queryStart, queryEnd, "select 1")
+	})
+	p.Finalize()
+
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	Export(context.Background(), tp.Tracer("test"), p)
+
+	spans := make(map[string]tracetest.SpanStub)
+	for _, s := range exp.GetSpans() {
+		spans[s.Name] = s
+	}
+	if len(spans) != 3 {
+		t.Fatalf("got %d spans, want 3: %v", len(spans), spans)
+	}
+	root, step, query := spans["/users/{id}"], spans["render"], spans["sql query"]
+	render := p.Root.Children[0]
+
+	if root.Parent.IsValid() {
+		t.Errorf("root span has parent %v", root.Parent.SpanID())
+	}
+	if step.Parent.SpanID() != root.SpanContext.SpanID() {
+		t.Errorf("render span is not a child of the root span")
+	}
+	if query.Parent.SpanID() != step.SpanContext.SpanID() {
+		t.Errorf("sql span is not a child of the render span")
+	}
+	if root.SpanContext.TraceID() != step.SpanContext.TraceID() || root.SpanContext.TraceID() != query.SpanContext.TraceID() {
+		t.Errorf("spans are in different traces")
+	}
+
+	for _, c := range []struct {
+		name      string
+		got, want time.Time
+	}{
+		{"root start", root.StartTime, p.Start()},
+		{"root end", root.EndTime, at(p.Start(), p.DurationMilliseconds)},
+		{"render start", step.StartTime, at(p.Start(), render.StartMilliseconds)},
+		{"render end", step.EndTime, at(p.Start(), render.StartMilliseconds+render.DurationMilliseconds)},
+		{"sql start", query.StartTime, queryStart},
+		{"sql end", query.EndTime, queryEnd},
+	} {
+		if d := c.got.Sub(c.want); d < -time.Microsecond || d > time.Microsecond {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+
+	for k, want := range map[attribute.Key]string{
+		"miniprofiler.call_type":    "sql",
+		"miniprofiler.execute_type": "query",
+		"miniprofiler.command":      "select 1",
+	} {
+		if v := attr(query.Attributes, k); v != want {
+			t.Errorf("sql span %s = %q, want %q", k, v, want)
+		}
+	}
+	if v := attr(root.Attributes, "miniprofiler.id"); v != p.Id {
+		t.Errorf("root span miniprofiler.id = %q, want %q", v, p.Id)
+	}
+}
+
+// attr returns the value of key in attrs.
+func attr(attrs []attribute.KeyValue, key attribute.Key) string {
+	for _, kv := range attrs {
+		if kv.Key == key {
+			return kv.Value.Emit()
+		}
+	}
+	return ""
+}
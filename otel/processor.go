@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package otel
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanProcessor mirrors spans started under a profiled request into its
+// profile: client spans, such as database or HTTP calls, become custom timings
+// and other spans become steps. Spans are mirrored if the context they are
+// started with carries a miniprofiler Timer, or if their parent span was
+// mirrored. Spans that end after their profile is finalized are not
+// mirrored.
+type SpanProcessor struct {
+	sync.Mutex
+	spans map[trace.SpanID]*span
+
+	// sweep is the number of spans at which those of finalized profiles,
+	// which never ended, are next dropped.
+	sweep int
+}
+
+// minSweep is the least number of spans kept before the first sweep.
+const minSweep = 64
+
+// span is a mirrored span that has not ended.
+type span struct {
+	parent *miniprofiler.Timing
+	step   *miniprofiler.Timing // nil for custom timings
+}
+
+// timing returns the Timing that children of s belong to.
+func (s *span) timing() *miniprofiler.Timing {
+	if s.step != nil {
+		return s.step
+	}
+	return s.parent
+}
+
+// NewSpanProcessor returns a SpanProcessor. Register it with the
+// TracerProvider:
+//
+//	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(mpotel.NewSpanProcessor()))
+func NewSpanProcessor() *SpanProcessor {
+	return &SpanProcessor{
+		spans: make(map[trace.SpanID]*span),
+		sweep: minSweep,
+	}
+}
+
+func (sp *SpanProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	sp.Lock()
+	defer sp.Unlock()
+	var t *miniprofiler.Timing
+	if ps, ok := sp.spans[s.Parent().SpanID()]; ok && s.Parent().IsValid() {
+		t = ps.timing()
+	} else {
+		t = timing(miniprofiler.GetTimerFromContext(parent))
+	}
+	if t == nil || t.Finalized() {
+		return
+	}
+	m := &span{parent: t}
+	if s.SpanKind() != trace.SpanKindClient {
+		m.step = t.BeginStepAt(s.Name(), s.StartTime())
+	}
+	sp.spans[s.SpanContext().SpanID()] = m
+	if len(sp.spans) >= sp.sweep {
+		for id, m := range sp.spans {
+			if m.parent.Finalized() {
+				delete(sp.spans, id)
+			}
+		}
+		sp.sweep = 2*len(sp.spans) + minSweep
+	}
+}
+
+func (sp *SpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	sp.Lock()
+	m, ok := sp.spans[s.SpanContext().SpanID()]
+	delete(sp.spans, s.SpanContext().SpanID())
+	sp.Unlock()
+	if !ok || m.parent.Finalized() {
+		return
+	}
+	if m.step != nil {
+		m.step.EndStepAt(s.EndTime())
+		return
+	}
+	attrs := make(map[attribute.Key]string)
+	for _, kv := range s.Attributes() {
+		attrs[kv.Key] = kv.Value.Emit()
+	}
+	m.parent.AddCustomTiming(
+		callType(attrs, s.Name()),
+		first(attrs, s.Name(), "db.operation.name", "db.operation", "http.request.method", "http.method", "rpc.method"),
+		s.StartTime(), s.EndTime(),
+		first(attrs, s.Name(), "db.query.text", "db.statement", "url.full", "http.url"),
+	)
+}
+
+func (sp *SpanProcessor) Shutdown(context.Context) error {
+	return nil
+}
+
+func (sp *SpanProcessor) ForceFlush(context.Context) error {
+	return nil
+}
+
+// timing returns the Timing under which t records steps, or nil if t is not
+// profiling.
+func timing(t miniprofiler.Timer) *miniprofiler.Timing {
+	switch t := t.(type) {
+	case *miniprofiler.Profile:
+		return t.Root
+	case *miniprofiler.Timing:
+		return t
+	}
+	return nil
+}
+
+// callType returns the custom timing call type for a span with attrs: its
+// database, RPC or messaging system, "http" for HTTP requests, or name.
+func callType(attrs map[attribute.Key]string, name string) string {
+	if v := first(attrs, "", "db.system.name", "db.system", "rpc.system", "messaging.system"); v != "" {
+		return v
+	}
+	if first(attrs, "", "http.request.method", "http.method") != "" {
+		return "http"
+	}
+	return name
+}
+
+// first returns the value of the first of keys present in attrs, or def.
+func first(attrs map[attribute.Key]string, def string, keys ...attribute.Key) string {
+	for _, k := range keys {
+		if v, ok := attrs[k]; ok && v != "" {
+			return v
+		}
+	}
+	return def
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package otel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newProcessorTest() (*SpanProcessor, trace.Tracer, func() *miniprofiler.Profile) {
+	sp := NewSpanProcessor()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sp))
+	mp := miniprofiler.New()
+	mp.Store = func(*http.Request, *miniprofiler.Profile) {}
+	newProfile := func() *miniprofiler.Profile {
+		return mp.NewProfile(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil), "/")
+	}
+	return sp, tp.Tracer("test"), newProfile
+}
+
+func TestSpanProcessor(t *testing.T) {
+	_, tracer, newProfile := newProcessorTest()
+	p := newProfile()
+	ctx := miniprofiler.NewContext(context.Background(), p)
+	ctx, span := tracer.Start(ctx, "handler")
+	_, query := tracer.Start(ctx, "SELECT", trace.WithSpanKind(trace.SpanKindClient))
+	query.End()
+	span.End()
+	p.Finalize()
+
+	if len(p.Root.Children) != 1 || p.Root.Children[0].Name != "handler" {
+		t.Fatalf("root steps = %v, want handler", p.Root.Children)
+	}
+	step := p.Root.Children[0]
+	if step.DurationMilliseconds <= 0 {
+		t.Errorf("handler step duration = %v, want > 0", step.DurationMilliseconds)
+	}
+	if n := len(step.CustomTimings["SELECT"]); n != 1 {
+		t.Errorf("handler step has %d SELECT custom timings, want 1", n)
+	}
+}
+
+func TestSpanProcessorEndAfterFinalize(t *testing.T) {
+	_, tracer, newProfile := newProcessorTest()
+	p := newProfile()
+	ctx := miniprofiler.NewContext(context.Background(), p)
+	ctx, span := tracer.Start(ctx, "background")
+	_, query := tracer.Start(ctx, "SELECT", trace.WithSpanKind(trace.SpanKindClient))
+	p.Finalize()
+	b := string(p.Json())
+	query.End()
+	span.End()
+	_, late := tracer.Start(ctx, "late")
+	late.End()
+
+	if a := string(p.Json()); a != b {
+		t.Errorf("profile changed after Finalize:\nbefore: %s\nafter:  %s", b, a)
+	}
+}
+
+func TestSpanProcessorDropsUnended(t *testing.T) {
+	sp, tracer, newProfile := newProcessorTest()
+	for i := 0; i < 1000; i++ {
+		p := newProfile()
+		tracer.Start(miniprofiler.NewContext(context.Background(), p), "never ended")
+		p.Finalize()
+	}
+	sp.Lock()
+	n := len(sp.spans)
+	sp.Unlock()
+	if n > 2*minSweep {
+		t.Errorf("%d spans of finalized profiles kept, want at most %d", n, 2*minSweep)
+	}
+}
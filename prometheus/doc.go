@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+/*
+Package prometheus exports Prometheus metrics computed from mini-profiler
+profiles.
+
+To use this package, import:
+
+	import mpprom "github.com/MiniProfiler/go/prometheus"
+
+Register a Collector and add its Observe method to the profiler:
+
+	c := mpprom.NewCollector()
+	prometheus.MustRegister(c)
+	miniprofiler.DefaultProfiler.OnFinalize = append(
+		miniprofiler.DefaultProfiler.OnFinalize,
+		c.Observe,
+	)
+
+Every profiled request, whether or not Keep stores it, updates these
+histograms:
+
+	miniprofiler_request_duration_seconds{route, method, code}
+	miniprofiler_custom_timing_duration_seconds{call_type, execute_type}
+	miniprofiler_custom_timings_per_request{call_type}
+
+route is the profile's name. Set miniprofiler.Profiler.RouteName, or use one
+of the router adapters, so that it is a route template such as "/users/{id}"
+rather than a URL path, which would give each URL its own time series.
+
+Only profiled requests are observed, so the Enable function determines the
+sampling of these metrics.
+*/
+package prometheus
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2013 Matt Jibson <matt.jibson@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package prometheus
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/MiniProfiler/go/miniprofiler"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Collector is a prometheus.Collector of histograms updated by Observe.
+type Collector struct {
+	requestDuration      *prometheus.HistogramVec
+	customTimingDuration *prometheus.HistogramVec
+	customTimingCount    *prometheus.HistogramVec
+}
+
+// NewCollector returns a Collector with the default buckets.
+func NewCollector() *Collector {
+	return &Collector{
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "miniprofiler",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of profiled requests.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"route", "method", "code"}),
+		customTimingDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "miniprofiler",
+			Name:      "custom_timing_duration_seconds",
+			Help:      "Duration of custom timings, such as SQL queries, in profiled requests.",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+		}, []string{"call_type", "execute_type"}),
+		customTimingCount: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "miniprofiler",
+			Name:      "custom_timings_per_request",
+			Help:      "Number of custom timings of each call type in profiled requests that made any.",
+			Buckets:   []float64{1, 2, 5, 10, 20, 50, 100, 200, 500},
+		}, []string{"call_type"}),
+	}
+}
+
+// Observe updates the histograms with p. It is suitable for
+// miniprofiler.Profiler.OnFinalize.
+func (c *Collector) Observe(r *http.Request, p *miniprofiler.Profile) {
+	if p.Root == nil {
+		return
+	}
+	code := ""
+	if p.StatusCode != 0 {
+		code = strconv.Itoa(p.StatusCode)
+	}
+	c.requestDuration.WithLabelValues(p.Name, r.Method, code).Observe(p.DurationMilliseconds / 1000)
+	counts := make(map[string]int)
+	p.Walk(func(t *miniprofiler.Timing) {
+		for callType, cts := range t.CustomTimings {
+			for _, ct := range cts {
+				counts[callType]++
+				c.customTimingDuration.WithLabelValues(callType, ct.ExecuteType).Observe(ct.DurationMilliseconds / 1000)
+			}
+		}
+	})
+	for callType, n := range counts {
+		c.customTimingCount.WithLabelValues(callType).Observe(float64(n))
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requestDuration.Describe(ch)
+	c.customTimingDuration.Describe(ch)
+	c.customTimingCount.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requestDuration.Collect(ch)
+	c.customTimingDuration.Collect(ch)
+	c.customTimingCount.Collect(ch)
+}